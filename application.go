@@ -5,21 +5,46 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/signal"
 	"sync"
-	"sync/atomic"
 	"syscall"
 	"time"
 )
 
 type Application struct {
-	meta            meta
-	registry        *registry
-	runner          *runner
-	logger          Logger
-	hooks           []Hook
-	isRunning       atomic.Bool
-	shutdownTimeout time.Duration
+	meta              meta
+	registry          *registry
+	runner            *runner
+	logger            Logger
+	hooks             []Hook
+	interceptors      []Interceptor
+	states            *stateTracker
+	startupTimeout    time.Duration
+	startTimeouts     map[string]time.Duration
+	readyTimeout      time.Duration
+	shutdownTimeout   time.Duration
+	hardDeadline      time.Duration
+	stopTimeouts      map[string]time.Duration
+	dumpStacks        bool
+	signals           []os.Signal
+	signalHandlers    map[os.Signal]SignalHandler
+	signalHandling    bool
+	signalForceExit   bool
+	exitCodes         ExitCodes
+	healthTimeout     time.Duration
+	nonCritical       map[string]struct{}
+	healthMonitor     *healthMonitor
+	restartPolicies   map[string]RestartPolicy
+	supervision       SupervisionStrategy
+	parallelLifecycle bool
+	maxConcurrency    int
+
+	reloadMu sync.Mutex
+
+	mu          sync.Mutex
+	cancel      context.CancelCauseFunc
+	done        chan struct{}
+	rollingBack chan struct{}
+	runErr      error
 }
 
 func New(opts ...Option) (*Application, error) {
@@ -29,7 +54,18 @@ func New(opts ...Option) (*Application, error) {
 		registry:        reg,
 		logger:          &noopLogger{},
 		shutdownTimeout: 10 * time.Second,
+		healthTimeout:   5 * time.Second,
+		readyTimeout:    30 * time.Second,
+		healthMonitor:   &healthMonitor{},
+		states:          newStateTracker(),
+		done:            make(chan struct{}),
+		rollingBack:     make(chan struct{}),
+		signals:         defaultSignals(),
+		signalHandling:  true,
+		signalForceExit: true,
+		exitCodes:       DefaultExitCodes(),
 	}
+	a.signalHandlers = map[os.Signal]SignalHandler{syscall.SIGHUP: a.reloadOnSignal}
 
 	for _, opt := range opts {
 		if err := opt(a); err != nil {
@@ -37,114 +73,289 @@ func New(opts ...Option) (*Application, error) {
 		}
 	}
 
+	a.healthMonitor.logger = a.logger
+	a.healthMonitor.check = a.checkAll
+	a.healthMonitor.notify = a.runHooksHealthChange
+
 	a.runner = &runner{
-		registry: reg,
-		logger:   a.logger,
+		registry:        reg,
+		states:          a.states,
+		logger:          a.logger,
+		parallel:        a.parallelLifecycle,
+		maxConcurrency:  a.maxConcurrency,
+		startTimeouts:   a.startTimeouts,
+		readyTimeout:    a.readyTimeout,
+		stopTimeouts:    a.stopTimeouts,
+		shutdownTimeout: a.shutdownTimeout,
+		dumpStacks:      a.dumpStacks,
+		interceptors:    a.interceptors,
+		onRollback:      sync.OnceFunc(func() { close(a.rollingBack) }),
 	}
 
 	return a, nil
 }
 
 func (a *Application) Register(module Module) error {
-	return a.registry.register(module)
+	if err := a.registry.register(module); err != nil {
+		return err
+	}
+	a.states.addModule(module.Name())
+	return nil
 }
 
-func (a *Application) Health(ctx context.Context) error {
-	var errs []error
-	for _, m := range a.registry.getAll() {
-		if hc, ok := m.(HealthChecker); ok {
-			if err := hc.Health(ctx); err != nil {
-				errs = append(errs, fmt.Errorf("module %q: %w", m.Name(), err))
-			}
-		}
-	}
-	return errors.Join(errs...)
+func (a *Application) RegisterBarrier() error {
+	return a.registry.barrier()
 }
 
 func (a *Application) Uptime() time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	return a.meta.uptime()
 }
 
 func (a *Application) Run(ctx context.Context) error {
-	if !a.isRunning.CompareAndSwap(false, true) {
+	if err := a.states.transitionApp(StateInitializing, nil); err != nil {
+		if state := a.State(); state == StateStopped || state == StateFailed {
+			return ErrApplicationAlreadyStopped
+		}
 		return ErrApplicationAlreadyRunning
 	}
 
+	err := a.run(ctx)
+
+	a.mu.Lock()
+	a.runErr = err
+	a.meta.stopTime = time.Now()
+	a.mu.Unlock()
+
+	final := StateFailed
+	if a.State() == StateStopping && !errors.Is(err, ErrHardDeadlineExceeded) && !errors.Is(err, ErrShutdownForced) {
+		final = StateStopped
+	}
+	a.transition(final, err)
+
+	close(a.done)
+	return err
+}
+
+func (a *Application) State() State {
+	return a.states.appState()
+}
+
+func (a *Application) ModuleState(name string) (State, bool) {
+	return a.states.moduleState(name)
+}
+
+func (a *Application) ModuleStates() map[string]State {
+	return a.states.moduleStates()
+}
+
+func (a *Application) WatchState() (<-chan StateEvent, func()) {
+	return a.states.watch.subscribe()
+}
+
+func (a *Application) Stop(reason error) error {
+	select {
+	case <-a.done:
+		return ErrApplicationAlreadyStopped
+	default:
+	}
+
+	a.mu.Lock()
+	cancel := a.cancel
+	a.mu.Unlock()
+
+	if cancel == nil {
+		return ErrApplicationNotRunning
+	}
+
+	cancel(&ShutdownCause{Reason: ShutdownReasonStopRequested, Err: reason})
+	return nil
+}
+
+func (a *Application) Done() <-chan struct{} {
+	return a.done
+}
+
+func (a *Application) Wait() error {
+	<-a.done
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.runErr
+}
+
+func (a *Application) run(ctx context.Context) error {
 	a.registry.lock()
+	if err := a.registry.resolve(); err != nil {
+		return fmt.Errorf("resolve module dependencies: %w", err)
+	}
 
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	parent := ctx
+	ctx, cancel := context.WithCancelCause(context.WithoutCancel(parent))
+	defer cancel(nil)
+	stopParentWatch := context.AfterFunc(parent, func() {
+		cancel(&ShutdownCause{Reason: ShutdownReasonContextDone, Err: context.Cause(parent)})
+	})
+	defer stopParentWatch()
 
+	a.mu.Lock()
+	a.cancel = cancel
 	a.meta.startTime = time.Now()
 	ctx = a.meta.enrichContext(ctx)
+	a.mu.Unlock()
+
+	forced, stopSignals := a.handleSignals(ctx, cancel)
+	defer stopSignals()
+
+	if err := a.startupWithin(ctx, forced); err != nil {
+		return err
+	}
+
+	background := a.collectBackgroundErrors(ctx)
+
+	a.healthMonitor.start(ctx)
+	a.transition(StateRunning, nil)
+	a.logger.Info("application started")
+
+	select {
+	case <-ctx.Done():
+		a.logger.Info("shutdown signal received")
+	case bgErr := <-background.failed():
+		cause := &ShutdownCause{Reason: ShutdownReasonBackgroundFailure, Err: bgErr}
+		var moduleErr *ModuleError
+		if errors.As(bgErr, &moduleErr) {
+			cause.Module = moduleErr.Module
+		}
+		cancel(cause)
+	}
+
+	cause := causeFromContext(ctx)
+	a.logger.Info("shutting down", "reason", cause.Reason.String())
+
+	background.halt()
+	errs, err := a.shutdownWithin(background, forced)
+	if err != nil {
+		errs = []error{err}
+	}
+	switch {
+	case cause.Reason == ShutdownReasonBackgroundFailure && err == nil:
+		errs[0] = cause
+	case cause.Reason == ShutdownReasonBackgroundFailure,
+		cause.Reason == ShutdownReasonStopRequested && cause.Err != nil:
+		errs = append([]error{cause}, errs...)
+	}
+	return errors.Join(errs...)
+}
 
-	go a.setupSignalHandler(ctx, cancel)
+func (a *Application) startup(ctx context.Context) error {
+	if a.startupTimeout > 0 {
+		ctx = withStartupBudget(ctx, time.Now().Add(a.startupTimeout))
+	}
 
 	a.logger.Info("initializing modules")
 	if err := a.runner.initAll(ctx); err != nil {
 		return err
 	}
+	a.transition(StateInitialized, nil)
 
 	if err := a.runHooksBeforeStart(ctx); err != nil {
-		return fmt.Errorf("before start hook: %w", err)
+		a.logger.Error("before start hook failed, cleaning up", "error", err)
+		shutdownErr := a.runner.rollback(a.registry.getAll())
+		return errors.Join(hookError("before start", err), shutdownErr)
 	}
 
+	a.transition(StateStarting, nil)
 	a.logger.Info("starting modules")
-	startedModules, err := a.runner.startAll(ctx)
-	if err != nil {
+	if _, err := a.runner.startAll(ctx); err != nil {
 		return err
 	}
 
 	if err := a.runHooksAfterStart(ctx); err != nil {
 		a.logger.Error("after start hook failed, shutting down", "error", err)
-		shutdownErr := a.runner.shutdownModules(context.Background(), startedModules)
-		return errors.Join(fmt.Errorf("after start hook: %w", err), shutdownErr)
+		shutdownErr := a.runner.rollback(a.registry.getAll())
+		return errors.Join(hookError("after start", err), shutdownErr)
 	}
+	return nil
+}
 
-	bgErrCh := a.collectBackgroundErrors()
+func (a *Application) startupWithin(ctx context.Context, forced <-chan os.Signal) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- a.startup(ctx)
+	}()
 
-	a.logger.Info("application started")
+	select {
+	case err := <-done:
+		return err
+	case <-a.rollingBack:
+	case sig := <-forced:
+		return shutdownForced(sig)
+	}
 
+	deadline, stop := a.hardDeadlineTimer()
+	defer stop()
 	select {
-	case <-ctx.Done():
-		a.logger.Info("shutdown signal received")
-	case bgErr := <-bgErrCh:
-		a.logger.Error("background module failed", "error", bgErr)
-		cancel()
+	case err := <-done:
+		return err
+	case <-deadline:
+		a.logger.Error("hard shutdown deadline exceeded, abandoning startup rollback", "deadline", a.hardDeadline)
+		return ErrHardDeadlineExceeded
+	case sig := <-forced:
+		return shutdownForced(sig)
 	}
+}
 
-	return a.shutdown()
+func shutdownForced(sig os.Signal) error {
+	return fmt.Errorf("%w: %w", ErrShutdownForced, &ShutdownCause{Reason: ShutdownReasonSignal, Signal: sig})
 }
 
-func (a *Application) shutdown() error {
-	defer func() {
-		a.meta.stopTime = time.Now()
-		a.isRunning.Store(false)
+func (a *Application) hardDeadlineTimer() (<-chan time.Time, func()) {
+	if a.hardDeadline <= 0 {
+		return nil, func() {}
+	}
+	timer := time.NewTimer(a.hardDeadline)
+	return timer.C, func() { timer.Stop() }
+}
+
+func (a *Application) shutdownWithin(background *supervisor, forced <-chan os.Signal) ([]error, error) {
+	var errs []error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		shutdownErr := a.shutdown()
+		errs = append(background.stop(), shutdownErr)
 	}()
 
+	deadline, stop := a.hardDeadlineTimer()
+	defer stop()
+
+	select {
+	case <-done:
+		return errs, nil
+	case <-deadline:
+		a.logger.Error("hard shutdown deadline exceeded, abandoning shutdown", "deadline", a.hardDeadline)
+		return nil, ErrHardDeadlineExceeded
+	case sig := <-forced:
+		return nil, shutdownForced(sig)
+	}
+}
+
+func (a *Application) shutdown() error {
+	a.reloadMu.Lock()
+	a.transition(StateStopping, nil)
+	a.reloadMu.Unlock()
+	a.healthMonitor.stop()
+
 	hookCtx := context.Background()
 	if err := a.runHooksBeforeStop(hookCtx); err != nil {
 		a.logger.Error("before stop hook failed", "error", err)
 	}
 
-	var shutdownErr error
-	if a.shutdownTimeout > 0 {
-		shutdownCtx, timeoutCancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
-		defer timeoutCancel()
+	stopCtx, stopCancel := a.runner.shutdownContext()
+	defer stopCancel()
 
-		errCh := make(chan error, 1)
-		go func() {
-			errCh <- a.runner.shutdownAll(shutdownCtx)
-		}()
-
-		select {
-		case shutdownErr = <-errCh:
-		case <-shutdownCtx.Done():
-			shutdownErr = ErrGracefulShutdownTimedOut
-		}
-	} else {
-		shutdownErr = a.runner.shutdownAll(context.Background())
-	}
+	shutdownErr := a.runner.shutdownAll(stopCtx)
 
 	if shutdownErr != nil {
 		a.logger.Error("shutdown completed with errors", "error", shutdownErr)
@@ -154,65 +365,27 @@ func (a *Application) shutdown() error {
 
 	if err := a.runHooksAfterStop(hookCtx); err != nil {
 		a.logger.Error("after stop hook failed", "error", err)
-		shutdownErr = errors.Join(shutdownErr, fmt.Errorf("after stop hook: %w", err))
+		shutdownErr = errors.Join(shutdownErr, hookError("after stop", err))
 	}
 
 	return shutdownErr
 }
 
-func (a *Application) collectBackgroundErrors() <-chan error {
-	modules := a.registry.getAll()
-
-	var bgModules []BackgroundModule
-	for _, m := range modules {
-		if bg, ok := m.(BackgroundModule); ok {
-			bgModules = append(bgModules, bg)
-		}
-	}
-
-	if len(bgModules) == 0 {
-		return nil
-	}
-
-	merged := make(chan error, len(bgModules))
-	var wg sync.WaitGroup
-
-	for _, bg := range bgModules {
-		wg.Add(1)
-		go func(bg BackgroundModule) {
-			defer wg.Done()
-			if err, ok := <-bg.Err(); ok && err != nil {
-				merged <- fmt.Errorf("background module %q: %w", bg.Name(), err)
-			}
-		}(bg)
+func (a *Application) transition(to State, err error) {
+	if tErr := a.states.transitionApp(to, err); tErr != nil {
+		a.logger.Error("invalid application state transition", "error", tErr)
 	}
-
-	go func() {
-		wg.Wait()
-		close(merged)
-	}()
-
-	return merged
 }
 
-func (a *Application) setupSignalHandler(ctx context.Context, cancelFn context.CancelFunc) {
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	defer signal.Stop(sigChan)
-
-	select {
-	case sig := <-sigChan:
-		a.logger.Info("received signal", "signal", sig.String())
-		cancelFn()
-	case <-ctx.Done():
-		return
-	}
+func callHook(ctx context.Context, hook func(ctx context.Context) error) (err error) {
+	defer recoverPanic("", PhaseHook, &err)
+	return hook(ctx)
 }
 
 func (a *Application) runHooksBeforeStart(ctx context.Context) error {
 	for _, h := range a.hooks {
 		if h.BeforeStart != nil {
-			if err := h.BeforeStart(ctx); err != nil {
+			if err := callHook(ctx, h.BeforeStart); err != nil {
 				return err
 			}
 		}
@@ -223,7 +396,7 @@ func (a *Application) runHooksBeforeStart(ctx context.Context) error {
 func (a *Application) runHooksAfterStart(ctx context.Context) error {
 	for _, h := range a.hooks {
 		if h.AfterStart != nil {
-			if err := h.AfterStart(ctx); err != nil {
+			if err := callHook(ctx, h.AfterStart); err != nil {
 				return err
 			}
 		}
@@ -234,7 +407,7 @@ func (a *Application) runHooksAfterStart(ctx context.Context) error {
 func (a *Application) runHooksBeforeStop(ctx context.Context) error {
 	for _, h := range a.hooks {
 		if h.BeforeStop != nil {
-			if err := h.BeforeStop(ctx); err != nil {
+			if err := callHook(ctx, h.BeforeStop); err != nil {
 				return err
 			}
 		}
@@ -245,10 +418,46 @@ func (a *Application) runHooksBeforeStop(ctx context.Context) error {
 func (a *Application) runHooksAfterStop(ctx context.Context) error {
 	for _, h := range a.hooks {
 		if h.AfterStop != nil {
-			if err := h.AfterStop(ctx); err != nil {
+			if err := callHook(ctx, h.AfterStop); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Application) runHooksBeforeReload(ctx context.Context) error {
+	for _, h := range a.hooks {
+		if h.BeforeReload != nil {
+			if err := callHook(ctx, h.BeforeReload); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Application) runHooksAfterReload(ctx context.Context) error {
+	for _, h := range a.hooks {
+		if h.AfterReload != nil {
+			if err := callHook(ctx, h.AfterReload); err != nil {
 				return err
 			}
 		}
 	}
 	return nil
 }
+
+func (a *Application) runHooksHealthChange(ctx context.Context, transition HealthTransition) {
+	for _, h := range a.hooks {
+		if h.OnHealthChange != nil {
+			err := callHook(ctx, func(ctx context.Context) error {
+				h.OnHealthChange(ctx, transition)
+				return nil
+			})
+			if err != nil {
+				a.logger.Error("health change hook failed", "module", transition.Module, "error", err)
+			}
+		}
+	}
+}
@@ -3,7 +3,9 @@ package app
 import (
 	"context"
 	"errors"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -97,7 +99,7 @@ func TestApplication_Uptime(t *testing.T) {
 func TestApplication_Run_AlreadyRunning(t *testing.T) {
 	t.Parallel()
 	a := newTestApp()
-	a.isRunning.Store(true)
+	a.states.app = StateRunning
 	err := a.Run(context.Background())
 	if !errors.Is(err, ErrApplicationAlreadyRunning) {
 		t.Errorf("expected ErrApplicationAlreadyRunning, got %v", err)
@@ -116,6 +118,109 @@ func TestApplication_Run_InitError(t *testing.T) {
 	}
 }
 
+func TestApplication_Run_InitErrorCleansUp(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	cleaned := false
+	_ = a.Register(&mockCleanerModule{
+		mockModule: mockModule{name: "db"},
+		cleanupFn: func(ctx context.Context) error {
+			cleaned = true
+			return nil
+		},
+	})
+	_ = a.Register(&mockModule{name: "bad", initFn: func(ctx context.Context) error {
+		return errTest
+	}})
+	if err := a.Run(context.Background()); !errors.Is(err, errTest) {
+		t.Fatalf("expected errTest, got %v", err)
+	}
+	if !cleaned {
+		t.Error("expected initialized module to be cleaned up")
+	}
+	if s, _ := a.ModuleState("db"); s != StateStopped {
+		t.Errorf("expected db to be stopped, got %s", s)
+	}
+}
+
+func TestApplication_Run_StartupTimeout(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	stopped := false
+	a := newTestApp(WithStartupTimeout(30 * time.Millisecond))
+	_ = a.Register(&mockModule{name: "db", stopFn: func(ctx context.Context) error {
+		stopped = true
+		return nil
+	}})
+	_ = a.Register(&mockModule{name: "slow", startFn: func(ctx context.Context) error {
+		<-release
+		return nil
+	}})
+	err := a.Run(context.Background())
+	if !errors.Is(err, ErrStartupTimedOut) || !strings.Contains(err.Error(), `start module "slow"`) {
+		t.Fatalf("expected startup timeout for slow module, got %v", err)
+	}
+	if !stopped {
+		t.Error("expected started modules to be rolled back")
+	}
+	if a.State() != StateFailed {
+		t.Errorf("expected failed state, got %s", a.State())
+	}
+}
+
+func TestApplication_Run_StopDuringStartup(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "slow", initFn: func(ctx context.Context) error {
+		<-release
+		return nil
+	}})
+	cancel, errCh := runInBackground(a)
+	defer cancel()
+	waitFor(t, func() bool { return a.State() == StateInitializing })
+	_ = a.Stop(nil)
+	err := <-errCh
+	var cause *ShutdownCause
+	if !errors.Is(err, ErrStartupAborted) || !errors.As(err, &cause) || cause.Reason != ShutdownReasonStopRequested {
+		t.Errorf("expected startup aborted by stop request, got %v", err)
+	}
+}
+
+func TestApplication_Run_AfterStartWaitsForReady(t *testing.T) {
+	t.Parallel()
+	ready := make(chan struct{})
+	var readyBeforeHook bool
+	a := newTestApp(WithHook(Hook{
+		AfterStart: func(ctx context.Context) error {
+			select {
+			case <-ready:
+				readyBeforeHook = true
+			default:
+			}
+			return nil
+		},
+	}))
+	_ = a.Register(&mockReadyModule{
+		mockModule: mockModule{name: "http", startFn: func(ctx context.Context) error {
+			time.AfterFunc(20*time.Millisecond, func() { close(ready) })
+			return nil
+		}},
+		ready: ready,
+	})
+	cancel, errCh := runInBackground(a)
+	waitFor(t, isRunning(a))
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !readyBeforeHook {
+		t.Error("expected AfterStart to run after the module became ready")
+	}
+}
+
 func TestApplication_Run_BeforeStartHookError(t *testing.T) {
 	t.Parallel()
 	a := newTestApp(WithHook(Hook{
@@ -127,6 +232,33 @@ func TestApplication_Run_BeforeStartHookError(t *testing.T) {
 	}
 }
 
+func TestApplication_Run_BeforeStartHookErrorCleansUp(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithHook(Hook{
+		BeforeStart: func(ctx context.Context) error { return errTest },
+	}))
+	stopped, cleaned := false, false
+	_ = a.Register(&mockCleanerModule{
+		mockModule: mockModule{name: "db", stopFn: func(ctx context.Context) error {
+			stopped = true
+			return nil
+		}},
+		cleanupFn: func(ctx context.Context) error {
+			cleaned = true
+			return nil
+		},
+	})
+	if err := a.Run(context.Background()); !errors.Is(err, errTest) {
+		t.Fatalf("expected errTest, got %v", err)
+	}
+	if stopped {
+		t.Error("expected Stop not to be called for a module that never started")
+	}
+	if !cleaned {
+		t.Error("expected initialized module to be cleaned up")
+	}
+}
+
 func TestApplication_Run_StartError(t *testing.T) {
 	t.Parallel()
 	a := newTestApp()
@@ -181,7 +313,62 @@ func TestApplication_Run_BackgroundError(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
 	err := a.Run(ctx)
-	_ = err
+	if !errors.Is(err, errTest) {
+		t.Fatalf("expected errTest, got %v", err)
+	}
+	var cause *ShutdownCause
+	if !errors.As(err, &cause) {
+		t.Fatalf("expected ShutdownCause, got %v", err)
+	}
+	if cause.Reason != ShutdownReasonBackgroundFailure || cause.Module != "bgmod" {
+		t.Errorf("unexpected cause: %+v", cause)
+	}
+}
+
+func TestApplication_Run_BackgroundErrorJoinsShutdownErrors(t *testing.T) {
+	t.Parallel()
+	errStop := errors.New("stop error")
+	bg := newMockBgModule("bgmod")
+	bg.startFn = func(ctx context.Context) error {
+		bg.errCh <- errTest
+		return nil
+	}
+	bg.stopFn = func(ctx context.Context) error { return errStop }
+	a := newTestApp()
+	_ = a.Register(bg)
+	err := a.Run(context.Background())
+	if !errors.Is(err, errTest) || !errors.Is(err, errStop) {
+		t.Errorf("expected background and stop errors, got %v", err)
+	}
+}
+
+func TestApplication_Run_ContextCauseVisibleToModules(t *testing.T) {
+	t.Parallel()
+	causeCh := make(chan error, 1)
+	parentErr := errors.New("parent gone")
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "m1", startFn: func(ctx context.Context) error {
+		go func() {
+			<-ctx.Done()
+			causeCh <- context.Cause(ctx)
+		}()
+		return nil
+	}})
+	ctx, cancel := context.WithCancelCause(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel(parentErr)
+	}()
+	if err := a.Run(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var cause *ShutdownCause
+	if err := <-causeCh; !errors.As(err, &cause) {
+		t.Fatalf("expected ShutdownCause, got %v", err)
+	}
+	if cause.Reason != ShutdownReasonContextDone || !errors.Is(cause, parentErr) {
+		t.Errorf("unexpected cause: %+v", cause)
+	}
 }
 
 func TestApplication_Run_ShutdownTimeout(t *testing.T) {
@@ -201,6 +388,140 @@ func TestApplication_Run_ShutdownTimeout(t *testing.T) {
 	if !errors.Is(err, ErrGracefulShutdownTimedOut) {
 		t.Errorf("expected ErrGracefulShutdownTimedOut, got %v", err)
 	}
+	var timeoutErr *ShutdownTimeoutError
+	if !errors.As(err, &timeoutErr) || len(timeoutErr.InProgress) != 1 || timeoutErr.InProgress[0] != "slow" {
+		t.Errorf("expected slow module to be reported in progress, got %v", err)
+	}
+}
+
+func TestApplication_Run_StopContextCarriesBudget(t *testing.T) {
+	t.Parallel()
+	var budget time.Duration
+	var ok bool
+	a := newTestApp(WithGracefulTimeout(time.Minute))
+	_ = a.Register(&mockModule{name: "m", stopFn: func(ctx context.Context) error {
+		budget, ok = ShutdownBudgetFromContext(ctx)
+		return nil
+	}})
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	if err := a.Run(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ok || budget <= 0 || budget > time.Minute {
+		t.Errorf("expected remaining budget in stop context, got %v %v", budget, ok)
+	}
+}
+
+func TestApplication_Run_HardDeadline(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	a := newTestApp(WithGracefulTimeout(0), WithHardDeadline(20*time.Millisecond), WithHook(Hook{
+		AfterStop: func(ctx context.Context) error {
+			defer close(finished)
+			<-release
+			return nil
+		},
+	}))
+	_ = a.Register(&mockModule{name: "m"})
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	start := time.Now()
+	err := a.Run(ctx)
+	if !errors.Is(err, ErrHardDeadlineExceeded) {
+		t.Fatalf("expected ErrHardDeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("expected Run to return at the hard deadline")
+	}
+	if a.State() != StateFailed {
+		t.Errorf("expected failed state, got %s", a.State())
+	}
+
+	uptime := a.Uptime()
+	close(release)
+	<-finished
+	time.Sleep(10 * time.Millisecond)
+	if a.Uptime() != uptime {
+		t.Error("expected abandoned shutdown not to change uptime")
+	}
+}
+
+func TestApplication_Run_HardDeadlineBoundsStartupRollback(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	var hasBudget atomic.Bool
+	a := newTestApp(
+		WithGracefulTimeout(time.Minute),
+		WithHardDeadline(50*time.Millisecond),
+		WithHook(Hook{AfterStart: func(ctx context.Context) error { return errTest }}),
+	)
+	_ = a.Register(&mockModule{name: "slow", stopFn: func(ctx context.Context) error {
+		_, ok := ShutdownBudgetFromContext(ctx)
+		hasBudget.Store(ok)
+		<-release
+		return nil
+	}})
+
+	start := time.Now()
+	err := a.Run(context.Background())
+	if !errors.Is(err, ErrHardDeadlineExceeded) {
+		t.Fatalf("expected ErrHardDeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("expected Run to return at the hard deadline during rollback")
+	}
+	if !hasBudget.Load() {
+		t.Error("expected rollback to carry the shutdown budget")
+	}
+	if a.State() != StateFailed {
+		t.Errorf("expected failed state, got %s", a.State())
+	}
+}
+
+func TestApplication_Run_StartupRollbackUsesGracefulTimeout(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	a := newTestApp(
+		WithGracefulTimeout(50*time.Millisecond),
+		WithHook(Hook{AfterStart: func(ctx context.Context) error { return errTest }}),
+	)
+	_ = a.Register(&mockModule{name: "slow", stopFn: func(ctx context.Context) error {
+		<-release
+		return nil
+	}})
+
+	start := time.Now()
+	err := a.Run(context.Background())
+	if !errors.Is(err, errTest) || !errors.Is(err, ErrGracefulShutdownTimedOut) {
+		t.Fatalf("expected hook error and shutdown timeout, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("expected rollback to be bounded by the graceful timeout")
+	}
+}
+
+func TestApplication_Run_HardDeadlineKeepsStopReason(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	a := newTestApp(WithHardDeadline(20*time.Millisecond), WithHook(Hook{
+		BeforeStop: func(ctx context.Context) error {
+			<-release
+			return nil
+		},
+	}))
+	cancel, errCh := runInBackground(a)
+	defer cancel()
+	waitFor(t, isRunning(a))
+	_ = a.Stop(errTest)
+	err := <-errCh
+	if !errors.Is(err, ErrHardDeadlineExceeded) || !errors.Is(err, errTest) {
+		t.Errorf("expected hard deadline and stop reason, got %v", err)
+	}
 }
 
 func TestApplication_Run_ZeroTimeout(t *testing.T) {
@@ -224,8 +545,8 @@ func TestApplication_Run_IsRunningResets(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 	_ = a.Run(ctx)
-	if a.isRunning.Load() {
-		t.Error("expected isRunning to be false after Run completes")
+	if a.State() != StateStopped {
+		t.Errorf("expected stopped state after Run completes, got %s", a.State())
 	}
 }
 
@@ -316,3 +637,192 @@ func TestApplication_Shutdown_ModuleStopError(t *testing.T) {
 		t.Errorf("expected errTest, got %v", err)
 	}
 }
+
+func TestApplication_Run_DependencyOrder(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var events []string
+	record := func(event string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+			return nil
+		}
+	}
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "api", deps: []string{"db"}, startFn: record("start api"), stopFn: record("stop api")})
+	_ = a.Register(&mockModule{name: "db", startFn: record("start db"), stopFn: record("stop db")})
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	if err := a.Run(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{"start db", "start api", "stop api", "stop db"}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, events)
+		}
+	}
+}
+
+func TestApplication_Run_DependencyCycle(t *testing.T) {
+	t.Parallel()
+	initCalled := false
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "a", deps: []string{"b"}, initFn: func(ctx context.Context) error {
+		initCalled = true
+		return nil
+	}})
+	_ = a.Register(&mockModule{name: "b", deps: []string{"a"}})
+	err := a.Run(context.Background())
+	if !errors.Is(err, ErrDependencyCycle) {
+		t.Errorf("expected ErrDependencyCycle, got %v", err)
+	}
+	if initCalled {
+		t.Error("expected no module to be initialized")
+	}
+}
+
+func TestApplication_RegisterBarrier_AfterRun(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_ = a.Run(ctx)
+	if err := a.RegisterBarrier(); !errors.Is(err, ErrRegistrationClosed) {
+		t.Errorf("expected ErrRegistrationClosed, got %v", err)
+	}
+}
+
+func TestApplication_Run_CollectsErrorsDuringShutdown(t *testing.T) {
+	t.Parallel()
+	errLate := errors.New("late error")
+	bg1 := newMockBgModule("bg1")
+	bg1.startFn = func(ctx context.Context) error {
+		bg1.errCh <- errTest
+		return nil
+	}
+	bg2 := newMockBgModule("bg2")
+	bg2.stopFn = func(ctx context.Context) error {
+		bg2.errCh <- errLate
+		return nil
+	}
+	a := newTestApp()
+	_ = a.Register(bg2)
+	_ = a.Register(bg1)
+	err := a.Run(context.Background())
+	if !errors.Is(err, errTest) || !errors.Is(err, errLate) {
+		t.Errorf("expected both background errors, got %v", err)
+	}
+}
+
+func TestApplication_Run_BackgroundErrorDuringSignalShutdown(t *testing.T) {
+	t.Parallel()
+	bg := newMockBgModule("bg")
+	bg.stopFn = func(ctx context.Context) error {
+		bg.errCh <- errTest
+		return nil
+	}
+	a := newTestApp()
+	_ = a.Register(bg)
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	err := a.Run(ctx)
+	if !errors.Is(err, errTest) {
+		t.Errorf("expected background error reported during shutdown, got %v", err)
+	}
+	var cause *ShutdownCause
+	if errors.As(err, &cause) {
+		t.Errorf("expected no shutdown cause for context shutdown, got %v", cause)
+	}
+}
+
+func TestApplication_Stop_NotRunning(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	if err := a.Stop(nil); !errors.Is(err, ErrApplicationNotRunning) {
+		t.Errorf("expected ErrApplicationNotRunning, got %v", err)
+	}
+}
+
+func TestApplication_Stop_Graceful(t *testing.T) {
+	t.Parallel()
+	stopped := make(chan struct{})
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "m1", stopFn: func(ctx context.Context) error {
+		close(stopped)
+		return nil
+	}})
+	_, done := runInBackground(a)
+	waitFor(t, isRunning(a))
+	if err := a.Stop(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-a.Done()
+	if err := a.Wait(); err != nil {
+		t.Errorf("expected nil error for stop without reason, got %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("expected Run to return nil, got %v", err)
+	}
+	select {
+	case <-stopped:
+	default:
+		t.Error("expected module to be stopped")
+	}
+}
+
+func TestApplication_Stop_WithReason(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "m1"})
+	runInBackground(a)
+	waitFor(t, isRunning(a))
+	_ = a.Stop(errTest)
+	err := a.Wait()
+	if !errors.Is(err, errTest) {
+		t.Fatalf("expected reason to be returned, got %v", err)
+	}
+	var cause *ShutdownCause
+	if !errors.As(err, &cause) || cause.Reason != ShutdownReasonStopRequested {
+		t.Errorf("expected stop requested cause, got %v", err)
+	}
+}
+
+func TestApplication_Stop_AfterCompletion(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_ = a.Run(ctx)
+	if err := a.Stop(nil); !errors.Is(err, ErrApplicationAlreadyStopped) {
+		t.Errorf("expected ErrApplicationAlreadyStopped, got %v", err)
+	}
+}
+
+func TestApplication_Run_AfterCompletion(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_ = a.Run(ctx)
+	if err := a.Run(context.Background()); !errors.Is(err, ErrApplicationAlreadyStopped) {
+		t.Errorf("expected ErrApplicationAlreadyStopped, got %v", err)
+	}
+}
+
+func TestApplication_Wait_ReturnsRunError(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "bad", initFn: func(ctx context.Context) error { return errTest }})
+	go func() { _ = a.Run(context.Background()) }()
+	if err := a.Wait(); !errors.Is(err, errTest) {
+		t.Errorf("expected errTest, got %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type backgroundEvent struct {
+	module     BackgroundModule
+	generation int
+	err        error
+}
+
+type supervisor struct {
+	logger      Logger
+	runner      *runner
+	ctx         context.Context
+	stopTimeout time.Duration
+	strategy    SupervisionStrategy
+	policies    map[string]RestartPolicy
+
+	events  chan backgroundEvent
+	first   chan error
+	halting chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+
+	restartMu   sync.Mutex
+	mu          sync.Mutex
+	errs        []error
+	halted      bool
+	generations map[string]int
+	watchers    map[string]chan struct{}
+	restarts    map[string][]time.Time
+}
+
+func (a *Application) collectBackgroundErrors(ctx context.Context) *supervisor {
+	s := &supervisor{
+		logger:      a.logger,
+		runner:      a.runner,
+		ctx:         ctx,
+		stopTimeout: a.shutdownTimeout,
+		strategy:    a.supervision,
+		policies:    a.restartPolicies,
+		events:      make(chan backgroundEvent),
+		first:       make(chan error, 1),
+		halting:     make(chan struct{}),
+		done:        make(chan struct{}),
+		generations: make(map[string]int),
+		watchers:    make(map[string]chan struct{}),
+		restarts:    make(map[string][]time.Time),
+	}
+
+	for _, m := range a.registry.getAll() {
+		if bg, ok := m.(BackgroundModule); ok {
+			s.watch(bg)
+		}
+	}
+
+	s.wg.Add(1)
+	go s.loop()
+
+	return s
+}
+
+func (s *supervisor) failed() <-chan error {
+	return s.first
+}
+
+func (s *supervisor) watch(bg BackgroundModule) {
+	quit := make(chan struct{})
+	s.mu.Lock()
+	if previous, ok := s.watchers[bg.Name()]; ok {
+		close(previous)
+	}
+	s.watchers[bg.Name()] = quit
+	generation := s.generations[bg.Name()]
+	s.mu.Unlock()
+
+	_, supervised := s.policies[bg.Name()]
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		errCh := bg.Err()
+		for {
+			select {
+			case err, ok := <-errCh:
+				if !ok {
+					if supervised {
+						s.send(quit, backgroundEvent{module: bg, generation: generation, err: errModuleExited})
+					}
+					return
+				}
+				if err == nil {
+					continue
+				}
+				s.send(quit, backgroundEvent{module: bg, generation: generation, err: err})
+				if supervised {
+					return
+				}
+			case <-quit:
+				return
+			case <-s.done:
+				s.drain(bg.Name(), errCh)
+				return
+			}
+		}
+	}()
+}
+
+func (s *supervisor) send(quit <-chan struct{}, event backgroundEvent) {
+	select {
+	case s.events <- event:
+	case <-quit:
+	case <-s.done:
+		s.record(event.module.Name(), event.err)
+	}
+}
+
+func (s *supervisor) drain(name string, errCh <-chan error) {
+	for {
+		select {
+		case err, ok := <-errCh:
+			if !ok {
+				return
+			}
+			s.record(name, err)
+		default:
+			return
+		}
+	}
+}
+
+func (s *supervisor) loop() {
+	defer s.wg.Done()
+	for {
+		select {
+		case event := <-s.events:
+			s.handle(event)
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *supervisor) record(name string, err error) {
+	if err == nil || err == errModuleExited {
+		return
+	}
+
+	bgErr := moduleError(name, PhaseBackground, err)
+	s.logger.Error("background module failed", "module", name, "error", err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs = append(s.errs, bgErr)
+	if len(s.errs) == 1 {
+		s.first <- bgErr
+	}
+}
+
+func (s *supervisor) halt() {
+	s.mu.Lock()
+	if s.halted {
+		s.mu.Unlock()
+		return
+	}
+	s.halted = true
+	close(s.halting)
+	s.mu.Unlock()
+
+	s.restartMu.Lock()
+	defer s.restartMu.Unlock()
+}
+
+func (s *supervisor) stop() []error {
+	s.halt()
+	close(s.done)
+	s.wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([]error, len(s.errs))
+	copy(result, s.errs)
+	return result
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCollectBackgroundErrors_NoBgModules(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "plain"})
+	c := a.collectBackgroundErrors(context.Background())
+	select {
+	case err := <-c.failed():
+		t.Errorf("expected no failure, got %v", err)
+	default:
+	}
+	if errs := c.stop(); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+}
+
+func TestCollectBackgroundErrors_WithError(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	bg := newMockBgModule("bg1")
+	_ = a.Register(bg)
+	c := a.collectBackgroundErrors(context.Background())
+	bg.errCh <- errTest
+	err := <-c.failed()
+	if !errors.Is(err, errTest) {
+		t.Fatalf("expected errTest, got %v", err)
+	}
+	var moduleErr *ModuleError
+	if !errors.As(err, &moduleErr) || moduleErr.Module != "bg1" || moduleErr.Phase != PhaseBackground {
+		t.Errorf("expected module name in error, got %v", err)
+	}
+	if errs := c.stop(); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %v", errs)
+	}
+}
+
+func TestCollectBackgroundErrors_ChannelCloseNilErr(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	bg := newMockBgModule("bg1")
+	_ = a.Register(bg)
+	c := a.collectBackgroundErrors(context.Background())
+	bg.errCh <- nil
+	close(bg.errCh)
+	if errs := c.stop(); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+}
+
+func TestCollectBackgroundErrors_MultipleModules(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	bg1 := newMockBgModule("bg1")
+	bg2 := newMockBgModule("bg2")
+	_ = a.Register(bg1)
+	_ = a.Register(bg2)
+	c := a.collectBackgroundErrors(context.Background())
+	bg1.errCh <- errTest
+	<-c.failed()
+	close(bg2.errCh)
+	if errs := c.stop(); len(errs) != 1 {
+		t.Errorf("expected 1 error, got %v", errs)
+	}
+}
+
+func TestCollectBackgroundErrors_MultipleErrorsPerModule(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	bg := &mockBgModule{mockModule: mockModule{name: "bg"}, errCh: make(chan error)}
+	_ = a.Register(bg)
+	c := a.collectBackgroundErrors(context.Background())
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		for range 3 {
+			bg.errCh <- errTest
+		}
+	}()
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("expected module sends not to block")
+	}
+	if errs := c.stop(); len(errs) != 3 {
+		t.Errorf("expected 3 errors, got %v", errs)
+	}
+}
+
+func TestCollectBackgroundErrors_StopDrainsPending(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	bg := newMockBgModule("bg")
+	_ = a.Register(bg)
+	c := a.collectBackgroundErrors(context.Background())
+	bg.errCh <- errTest
+	errs := c.stop()
+	if len(errs) != 1 {
+		t.Errorf("expected pending error to be collected, got %v", errs)
+	}
+}
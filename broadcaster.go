@@ -0,0 +1,45 @@
+package app
+
+import "sync"
+
+const subscriberBuffer = 64
+
+type broadcaster[T any] struct {
+	mu   sync.Mutex
+	subs map[int]chan T
+	next int
+}
+
+func (b *broadcaster[T]) subscribe() (<-chan T, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subs == nil {
+		b.subs = make(map[int]chan T)
+	}
+	id := b.next
+	b.next++
+	ch := make(chan T, subscriberBuffer)
+	b.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.subs, id)
+			close(ch)
+		})
+	}
+}
+
+func (b *broadcaster[T]) publish(v T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, ch := range b.subs {
+		select {
+		case ch <- v:
+		default:
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package app
+
+import "testing"
+
+func TestBroadcaster_PublishToSubscribers(t *testing.T) {
+	t.Parallel()
+	var b broadcaster[int]
+	ch1, cancel1 := b.subscribe()
+	defer cancel1()
+	ch2, cancel2 := b.subscribe()
+	defer cancel2()
+	b.publish(42)
+	if v := <-ch1; v != 42 {
+		t.Errorf("expected 42, got %d", v)
+	}
+	if v := <-ch2; v != 42 {
+		t.Errorf("expected 42, got %d", v)
+	}
+}
+
+func TestBroadcaster_UnsubscribeClosesChannel(t *testing.T) {
+	t.Parallel()
+	var b broadcaster[int]
+	ch, cancel := b.subscribe()
+	cancel()
+	cancel()
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed")
+	}
+	b.publish(1)
+}
+
+func TestBroadcaster_DropsWhenFull(t *testing.T) {
+	t.Parallel()
+	var b broadcaster[int]
+	ch, cancel := b.subscribe()
+	defer cancel()
+	for i := range subscriberBuffer + 10 {
+		b.publish(i)
+	}
+	if len(ch) != subscriberBuffer {
+		t.Errorf("expected %d buffered values, got %d", subscriberBuffer, len(ch))
+	}
+}
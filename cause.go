@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+type ShutdownReason int
+
+const (
+	ShutdownReasonSignal ShutdownReason = iota + 1
+	ShutdownReasonContextDone
+	ShutdownReasonBackgroundFailure
+	ShutdownReasonStopRequested
+)
+
+func (r ShutdownReason) String() string {
+	switch r {
+	case ShutdownReasonSignal:
+		return "signal"
+	case ShutdownReasonContextDone:
+		return "context done"
+	case ShutdownReasonBackgroundFailure:
+		return "background failure"
+	case ShutdownReasonStopRequested:
+		return "stop requested"
+	default:
+		return "unknown"
+	}
+}
+
+type ShutdownCause struct {
+	Reason ShutdownReason
+	Signal os.Signal
+	Module string
+	Err    error
+}
+
+func (c *ShutdownCause) Error() string {
+	switch {
+	case c.Reason == ShutdownReasonSignal && c.Signal != nil:
+		return fmt.Sprintf("shutdown: received signal %s", c.Signal)
+	case c.Err != nil:
+		return fmt.Sprintf("shutdown: %s: %v", c.Reason, c.Err)
+	default:
+		return fmt.Sprintf("shutdown: %s", c.Reason)
+	}
+}
+
+func (c *ShutdownCause) Unwrap() error {
+	return c.Err
+}
+
+func causeFromContext(ctx context.Context) *ShutdownCause {
+	var cause *ShutdownCause
+	if errors.As(context.Cause(ctx), &cause) {
+		return cause
+	}
+	return &ShutdownCause{Reason: ShutdownReasonContextDone, Err: context.Cause(ctx)}
+}
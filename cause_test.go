@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"syscall"
+	"testing"
+)
+
+func TestShutdownCause_Error(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		cause    *ShutdownCause
+		expected string
+	}{
+		{&ShutdownCause{Reason: ShutdownReasonSignal, Signal: syscall.SIGTERM}, "shutdown: received signal terminated"},
+		{&ShutdownCause{Reason: ShutdownReasonContextDone, Err: context.Canceled}, "shutdown: context done: context canceled"},
+		{&ShutdownCause{Reason: ShutdownReasonBackgroundFailure, Err: &ModuleError{Module: "http", Phase: PhaseBackground, Err: errTest}}, `shutdown: background failure: background module "http": test error`},
+		{&ShutdownCause{Reason: ShutdownReasonStopRequested}, "shutdown: stop requested"},
+	}
+	for _, tc := range cases {
+		if got := tc.cause.Error(); got != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+func TestShutdownCause_Unwrap(t *testing.T) {
+	t.Parallel()
+	cause := &ShutdownCause{Reason: ShutdownReasonBackgroundFailure, Err: &ModuleError{Module: "bg", Phase: PhaseBackground, Err: errTest}}
+	if !errors.Is(cause, errTest) {
+		t.Error("expected cause to unwrap to errTest")
+	}
+}
+
+func TestShutdownReason_String(t *testing.T) {
+	t.Parallel()
+	if s := ShutdownReason(0).String(); s != "unknown" {
+		t.Errorf("expected unknown, got %q", s)
+	}
+}
+
+func TestCauseFromContext(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(&ShutdownCause{Reason: ShutdownReasonSignal, Signal: syscall.SIGINT})
+	if c := causeFromContext(ctx); c.Reason != ShutdownReasonSignal {
+		t.Errorf("expected signal reason, got %v", c.Reason)
+	}
+
+	plain, plainCancel := context.WithCancel(context.Background())
+	plainCancel()
+	c := causeFromContext(plain)
+	if c.Reason != ShutdownReasonContextDone || !errors.Is(c, context.Canceled) {
+		t.Errorf("expected context done reason wrapping context.Canceled, got %v", c)
+	}
+}
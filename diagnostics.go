@@ -0,0 +1,61 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+func (a *Application) WriteDiagnostics(w io.Writer) error {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "application: %q version %q environment %q\n", a.meta.name, a.meta.version, a.meta.environment)
+	fmt.Fprintf(&b, "state: %s\n", a.State())
+	if uptime := a.Uptime(); uptime > 0 {
+		fmt.Fprintf(&b, "uptime: %s\n", uptime.Round(time.Millisecond))
+	}
+
+	b.WriteString("modules:\n")
+	for _, m := range a.registry.getAll() {
+		state, _ := a.states.moduleState(m.Name())
+		fmt.Fprintf(&b, "  %s: %s\n", m.Name(), state)
+	}
+
+	if report, ok := a.healthMonitor.cached(); ok {
+		fmt.Fprintf(&b, "health: %s (checked at %s)\n", report.Status, report.Timestamp.Format(time.RFC3339))
+		for _, m := range report.Modules {
+			fmt.Fprintf(&b, "  %s: %s in %s", m.Name, m.Status, m.Latency)
+			if m.Error != "" {
+				fmt.Fprintf(&b, ": %s", m.Error)
+			}
+			b.WriteString("\n")
+		}
+	} else {
+		b.WriteString("health: no results\n")
+	}
+
+	b.WriteString("goroutines:\n")
+	b.Write(goroutineStacks())
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+func (a *Application) diagnosticsHandler(w io.Writer) SignalHandler {
+	return func(_ context.Context, sig os.Signal) {
+		a.logger.Info("writing diagnostics", "signal", sig.String())
+		if w != nil {
+			if err := a.WriteDiagnostics(w); err != nil {
+				a.logger.Error("failed to write diagnostics", "error", err)
+			}
+			return
+		}
+
+		var b bytes.Buffer
+		_ = a.WriteDiagnostics(&b)
+		a.logger.Info("diagnostics", "dump", b.String())
+	}
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestApplication_WriteDiagnostics(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithName("svc"), WithVersion("1.0"), WithHealthMonitor(time.Hour, 0, 1))
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "db"}, healthFn: func(ctx context.Context) error { return errTest }})
+	_ = a.Register(&mockModule{name: "cache"})
+	cancel, errCh := runInBackground(a)
+	defer func() {
+		cancel()
+		<-errCh
+	}()
+	waitFor(t, isRunning(a))
+
+	var b strings.Builder
+	if err := a.WriteDiagnostics(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		`application: "svc" version "1.0"`,
+		"state: running",
+		"uptime: ",
+		"  db: running",
+		"  cache: running",
+		"health: unhealthy",
+		"test error",
+		"goroutines:\ngoroutine ",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected diagnostics to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestApplication_WriteDiagnostics_NotStarted(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "m"})
+	var b strings.Builder
+	if err := a.WriteDiagnostics(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := b.String()
+	if strings.Contains(out, "uptime:") {
+		t.Errorf("expected no uptime before start, got:\n%s", out)
+	}
+	if !strings.Contains(out, "  m: created") || !strings.Contains(out, "health: no results") {
+		t.Errorf("unexpected diagnostics:\n%s", out)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errTest }
+
+func TestApplication_WriteDiagnostics_WriteError(t *testing.T) {
+	t.Parallel()
+	if err := newTestApp().WriteDiagnostics(failingWriter{}); !errors.Is(err, errTest) {
+		t.Errorf("expected errTest, got %v", err)
+	}
+}
+
+func TestDiagnosticsHandler_Writer(t *testing.T) {
+	t.Parallel()
+	var b strings.Builder
+	a := newTestApp(WithDiagnosticsSignal(syscall.SIGQUIT, &b))
+	a.signalHandlers[syscall.SIGQUIT](context.Background(), syscall.SIGQUIT)
+	if !strings.Contains(b.String(), "state: created") {
+		t.Errorf("expected diagnostics to be written, got %q", b.String())
+	}
+}
+
+func TestDiagnosticsHandler_Logger(t *testing.T) {
+	t.Parallel()
+	l := &mockLogger{}
+	a := newTestApp(WithLogger(l), WithDiagnosticsSignal(syscall.SIGUSR1, nil))
+	a.signalHandlers[syscall.SIGUSR1](context.Background(), syscall.SIGUSR1)
+	if !slices.Contains(l.infos, "diagnostics") {
+		t.Errorf("expected diagnostics to be logged, got %v", l.infos)
+	}
+
+	a = newTestApp(WithLogger(l), WithDiagnosticsSignal(syscall.SIGUSR1, failingWriter{}))
+	a.signalHandlers[syscall.SIGUSR1](context.Background(), syscall.SIGUSR1)
+	if !slices.Contains(l.errs, "failed to write diagnostics") {
+		t.Errorf("expected write failure to be logged, got %v", l.errs)
+	}
+}
@@ -1,14 +1,77 @@
 package app
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrApplicationAlreadyRunning  = errors.New("application is already running")
 	ErrApplicationAlreadyStopped  = errors.New("application is already stopped")
+	ErrApplicationNotRunning      = errors.New("application is not running")
 	ErrGracefulShutdownTimedOut   = errors.New("graceful shutdown timed out")
+	ErrStartupTimedOut            = errors.New("startup timed out")
+	ErrStartupAborted             = errors.New("startup aborted")
+	ErrModuleStartTimedOut        = errors.New("module start timed out")
+	ErrModuleNotReady             = errors.New("module did not become ready")
+	ErrModuleStopTimedOut         = errors.New("module stop timed out")
+	ErrHardDeadlineExceeded       = errors.New("hard shutdown deadline exceeded")
+	ErrShutdownForced             = errors.New("shutdown forced by repeated signal")
+	ErrInvalidStateTransition     = errors.New("invalid state transition")
 	ErrRegistrationClosed         = errors.New("registration is closed: application already started")
 	ErrModuleAlreadyRegistered    = errors.New("module already registered")
 	ErrModuleNameEmpty            = errors.New("module name must not be empty")
+	ErrModuleUnhealthy            = errors.New("module is unhealthy")
+	ErrDependencyNotFound         = errors.New("module dependency not found")
+	ErrDependencyCycle            = errors.New("module dependency cycle detected")
 	ErrAppNameEmpty               = errors.New("application name must not be empty")
 	ErrShutdownTimeoutNonPositive = errors.New("shutdown timeout must be positive or zero")
+	ErrStartupTimeoutNonPositive  = errors.New("startup timeout must be positive")
+	ErrStartTimeoutNonPositive    = errors.New("module start timeout must be positive")
+	ErrReadyTimeoutNegative       = errors.New("ready timeout must be positive or zero")
+	ErrStopTimeoutNonPositive     = errors.New("module stop timeout must be positive")
+	ErrHardDeadlineNonPositive    = errors.New("hard shutdown deadline must be positive")
+	ErrSignalsEmpty               = errors.New("signal list must not be empty")
+	ErrSignalNil                  = errors.New("signal must not be nil")
+	ErrSignalHandlerNil           = errors.New("signal handler must not be nil")
+	ErrInterceptorNil             = errors.New("interceptor must not be nil")
+	ErrExitCodeOutOfRange         = errors.New("exit code must be between 1 and 255")
+	ErrHealthTimeoutNegative      = errors.New("health check timeout must be positive or zero")
+	ErrHealthIntervalNonPositive  = errors.New("health monitor interval must be positive")
+	ErrHealthJitterNegative       = errors.New("health monitor jitter must be positive or zero")
+	ErrHealthHistoryNonPositive   = errors.New("health monitor history size must be positive")
+	ErrInvalidRestartPolicy       = errors.New("invalid restart policy")
+	ErrInvalidSupervisionStrategy = errors.New("invalid supervision strategy")
+	ErrRestartLimitExceeded       = errors.New("restart limit exceeded")
+	ErrMaxConcurrencyNegative     = errors.New("max concurrency must be positive or zero")
 )
+
+type ModuleError struct {
+	Module string
+	Hook   string
+	Phase  Phase
+	Err    error
+}
+
+func (e *ModuleError) Error() string {
+	switch {
+	case e.Hook != "":
+		return fmt.Sprintf("%s hook: %v", e.Hook, e.Err)
+	case e.Module == "":
+		return fmt.Sprintf("%s: %v", e.Phase, e.Err)
+	default:
+		return fmt.Sprintf("%s module %q: %v", e.Phase, e.Module, e.Err)
+	}
+}
+
+func (e *ModuleError) Unwrap() error {
+	return e.Err
+}
+
+func moduleError(module string, phase Phase, err error) error {
+	return &ModuleError{Module: module, Phase: phase, Err: err}
+}
+
+func hookError(hook string, err error) error {
+	return &ModuleError{Hook: hook, Phase: PhaseHook, Err: err}
+}
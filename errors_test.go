@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestModuleError_Error(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		err      *ModuleError
+		expected string
+	}{
+		{&ModuleError{Module: "db", Phase: PhaseInit, Err: errTest}, `init module "db": test error`},
+		{&ModuleError{Module: "http", Phase: PhaseBackground, Err: errTest}, `background module "http": test error`},
+		{&ModuleError{Phase: PhaseHook, Err: errTest}, "hook: test error"},
+		{&ModuleError{Hook: "before start", Phase: PhaseHook, Err: errTest}, "before start hook: test error"},
+	}
+	for _, tc := range cases {
+		if got := tc.err.Error(); got != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+func TestModuleError_InitFailureThroughJoin(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	cleanupErr := errors.New("cleanup failed")
+	_ = a.Register(&mockCleanerModule{
+		mockModule: mockModule{name: "db"},
+		cleanupFn:  func(ctx context.Context) error { return cleanupErr },
+	})
+	_ = a.RegisterBarrier()
+	_ = a.Register(&mockModule{name: "cache", initFn: func(ctx context.Context) error { return errTest }})
+
+	err := a.Run(context.Background())
+	var moduleErr *ModuleError
+	if !errors.As(err, &moduleErr) {
+		t.Fatalf("expected ModuleError, got %v", err)
+	}
+	if moduleErr.Module != "cache" || moduleErr.Phase != PhaseInit {
+		t.Errorf("expected init failure of cache, got %s %q", moduleErr.Phase, moduleErr.Module)
+	}
+	if !errors.Is(err, errTest) || !errors.Is(err, cleanupErr) {
+		t.Errorf("expected both causes in joined error, got %v", err)
+	}
+}
+
+func TestModuleError_StartFailure(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "http", startFn: func(ctx context.Context) error { return errTest }})
+
+	err := a.Run(context.Background())
+	var moduleErr *ModuleError
+	if !errors.As(err, &moduleErr) || moduleErr.Module != "http" || moduleErr.Phase != PhaseStart {
+		t.Fatalf("expected start ModuleError for http, got %v", err)
+	}
+}
+
+func TestModuleError_HookFailure(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithHook(Hook{BeforeStart: func(ctx context.Context) error { return errTest }}))
+	_ = a.Register(&mockModule{name: "m"})
+
+	err := a.Run(context.Background())
+	var moduleErr *ModuleError
+	if !errors.As(err, &moduleErr) || moduleErr.Phase != PhaseHook || moduleErr.Hook != "before start" || moduleErr.Module != "" {
+		t.Fatalf("expected before start hook ModuleError, got %v", err)
+	}
+	if err.Error() != "before start hook: test error" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+}
+
+func TestModuleError_Health(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "db"},
+		healthFn:   func(ctx context.Context) error { return errTest },
+	})
+	cancel, errCh := runInBackground(a)
+	defer func() { cancel(); <-errCh }()
+	waitFor(t, isRunning(a))
+
+	err := a.Health(context.Background())
+	var moduleErr *ModuleError
+	if !errors.As(err, &moduleErr) || moduleErr.Module != "db" || moduleErr.Phase != PhaseHealth {
+		t.Fatalf("expected health ModuleError for db, got %v", err)
+	}
+}
+
+func TestModuleError_HookNames(t *testing.T) {
+	t.Parallel()
+	failing := func(ctx context.Context) error { return errTest }
+	a := newTestApp(WithHook(Hook{AfterStop: failing, BeforeReload: failing}))
+	_ = a.Register(&mockModule{name: "m"})
+	cancel, errCh := runInBackground(a)
+	waitFor(t, isRunning(a))
+
+	var moduleErr *ModuleError
+	if err := a.Reload(context.Background()); !errors.As(err, &moduleErr) || moduleErr.Hook != "before reload" {
+		t.Errorf("expected before reload hook ModuleError, got %v", err)
+	}
+
+	cancel()
+	if err := <-errCh; !errors.As(err, &moduleErr) || moduleErr.Hook != "after stop" {
+		t.Errorf("expected after stop hook ModuleError, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"syscall"
+)
+
+var osExit = os.Exit
+
+type ExitCodes struct {
+	InitFailure     int
+	StartFailure    int
+	BackgroundCrash int
+	ShutdownTimeout int
+	Signal          int
+	Failure         int
+}
+
+func DefaultExitCodes() ExitCodes {
+	return ExitCodes{
+		InitFailure:     78,
+		StartFailure:    69,
+		BackgroundCrash: 70,
+		ShutdownTimeout: 75,
+		Signal:          130,
+		Failure:         70,
+	}
+}
+
+func (c ExitCodes) valid() bool {
+	codes := []int{c.InitFailure, c.StartFailure, c.BackgroundCrash, c.ShutdownTimeout, c.Signal, c.Failure}
+	for _, code := range codes {
+		if code < 1 || code > 255 {
+			return false
+		}
+	}
+	return true
+}
+
+func (c ExitCodes) code(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var cause *ShutdownCause
+	var moduleErr *ModuleError
+	switch {
+	case errors.Is(err, ErrShutdownForced):
+		return c.signal(err)
+	case errors.As(err, &cause) && cause.Reason == ShutdownReasonBackgroundFailure:
+		return c.BackgroundCrash
+	case errors.As(err, &cause) && cause.Reason == ShutdownReasonSignal:
+		return c.signal(err)
+	case errors.Is(err, ErrStartupAborted):
+		return c.Failure
+	case errors.Is(err, ErrHardDeadlineExceeded), errors.Is(err, ErrGracefulShutdownTimedOut):
+		return c.ShutdownTimeout
+	case errors.Is(err, ErrDependencyNotFound), errors.Is(err, ErrDependencyCycle):
+		return c.InitFailure
+	case errors.As(err, &moduleErr) && moduleErr.Phase == PhaseInit:
+		return c.InitFailure
+	case errors.As(err, &moduleErr) && moduleErr.Phase == PhaseStart:
+		return c.StartFailure
+	default:
+		return c.Failure
+	}
+}
+
+func (c ExitCodes) signal(err error) int {
+	var cause *ShutdownCause
+	if !errors.As(err, &cause) || cause.Reason != ShutdownReasonSignal {
+		return c.Signal
+	}
+	if sig, ok := cause.Signal.(syscall.Signal); ok && sig > 0 && sig < 128 {
+		return 128 + int(sig)
+	}
+	return c.Signal
+}
+
+func Main(a *Application) {
+	a.RunAndExit(context.Background())
+}
+
+func (a *Application) RunAndExit(ctx context.Context) {
+	err := a.Run(ctx)
+	code := a.exitCodes.code(err)
+
+	args := []any{"state", a.State().String(), "uptime", a.Uptime().String(), "exit_code", code}
+	if err != nil {
+		a.logger.Error("application exited with error", append(args, "error", err)...)
+	} else {
+		a.logger.Info("application exited", args...)
+	}
+	osExit(code)
+}
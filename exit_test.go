@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestExitCodes_Code(t *testing.T) {
+	t.Parallel()
+	codes := DefaultExitCodes()
+	cases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"nil", nil, 0},
+		{"init", moduleError("db", PhaseInit, errTest), 78},
+		{"init with rollback", errors.Join(moduleError("db", PhaseInit, errTest), moduleError("cache", PhaseCleanup, errTest)), 78},
+		{"dependency", fmt.Errorf("resolve module dependencies: %w", ErrDependencyCycle), 78},
+		{"start", moduleError("http", PhaseStart, ErrModuleNotReady), 69},
+		{"background", errors.Join(&ShutdownCause{Reason: ShutdownReasonBackgroundFailure, Err: moduleError("bg", PhaseBackground, errTest)}), 70},
+		{"shutdown timeout", &ShutdownTimeoutError{Pending: []string{"db"}}, 75},
+		{"hard deadline", ErrHardDeadlineExceeded, 75},
+		{"forced by SIGINT", shutdownForced(syscall.SIGINT), 130},
+		{"forced by SIGTERM", shutdownForced(syscall.SIGTERM), 143},
+		{"forced without signal", ErrShutdownForced, 130},
+		{"signal during init", moduleError("db", PhaseInit, fmt.Errorf("%w: %w", ErrStartupAborted, &ShutdownCause{Reason: ShutdownReasonSignal, Signal: syscall.SIGTERM})), 143},
+		{"non-syscall signal", &ShutdownCause{Reason: ShutdownReasonSignal, Signal: mockSignal{}}, 130},
+		{"stop during start", moduleError("http", PhaseStart, fmt.Errorf("%w: %w", ErrStartupAborted, &ShutdownCause{Reason: ShutdownReasonStopRequested})), 70},
+		{"stop", moduleError("db", PhaseStop, errTest), 70},
+		{"other", errTest, 70},
+	}
+	for _, tc := range cases {
+		if got := codes.code(tc.err); got != tc.expected {
+			t.Errorf("%s: expected %d, got %d", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestExitCodes_Custom(t *testing.T) {
+	t.Parallel()
+	codes := ExitCodes{InitFailure: 1, StartFailure: 2, BackgroundCrash: 3, ShutdownTimeout: 4, Signal: 5, Failure: 6}
+	if got := codes.code(moduleError("http", PhaseStart, errTest)); got != 2 {
+		t.Errorf("expected 2, got %d", got)
+	}
+	if got := codes.code(errTest); got != 6 {
+		t.Errorf("expected 6, got %d", got)
+	}
+	if got := codes.code(ErrShutdownForced); got != 5 {
+		t.Errorf("expected 5, got %d", got)
+	}
+}
+
+func stubExit(t *testing.T) *int {
+	t.Helper()
+	code := -1
+	prev := osExit
+	osExit = func(c int) { code = c }
+	t.Cleanup(func() { osExit = prev })
+	return &code
+}
+
+func TestRunAndExit_Success(t *testing.T) {
+	code := stubExit(t)
+	logger := &mockLogger{}
+	a := newTestApp(WithLogger(logger))
+	_ = a.Register(&mockModule{name: "m"})
+
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	a.RunAndExit(ctx)
+
+	if *code != 0 {
+		t.Errorf("expected exit code 0, got %d", *code)
+	}
+	if logger.infos[len(logger.infos)-1] != "application exited" {
+		t.Errorf("expected final summary, got %v", logger.infos)
+	}
+}
+
+func TestRunAndExit_InitFailure(t *testing.T) {
+	code := stubExit(t)
+	logger := &mockLogger{}
+	a := newTestApp(WithLogger(logger))
+	_ = a.Register(&mockModule{name: "db", initFn: func(ctx context.Context) error { return errTest }})
+
+	a.RunAndExit(context.Background())
+
+	if *code != 78 {
+		t.Errorf("expected exit code 78, got %d", *code)
+	}
+	if logger.errs[len(logger.errs)-1] != "application exited with error" {
+		t.Errorf("expected final error summary, got %v", logger.errs)
+	}
+}
+
+func TestMain_BackgroundCrash(t *testing.T) {
+	code := stubExit(t)
+	bg := newMockBgModule("bg")
+	a := newTestApp(WithoutSignalHandling(), WithHook(Hook{AfterStart: func(ctx context.Context) error {
+		go func() { bg.errCh <- errTest }()
+		return nil
+	}}))
+	_ = a.Register(bg)
+
+	Main(a)
+
+	if *code != 70 {
+		t.Errorf("expected exit code 70, got %d", *code)
+	}
+}
+
+func TestRunAndExit_SignalDuringInit(t *testing.T) {
+	code := stubExit(t)
+	a := newTestApp(WithSignals(syscall.SIGUSR2))
+	initializing := make(chan struct{})
+	_ = a.Register(&mockModule{name: "db", initFn: func(ctx context.Context) error {
+		close(initializing)
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+	go func() {
+		<-initializing
+		_ = syscall.Kill(os.Getpid(), syscall.SIGUSR2)
+	}()
+
+	a.RunAndExit(context.Background())
+
+	if *code != 128+int(syscall.SIGUSR2) {
+		t.Errorf("expected exit code %d, got %d", 128+int(syscall.SIGUSR2), *code)
+	}
+}
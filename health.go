@@ -0,0 +1,211 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type HealthStatus string
+
+const (
+	HealthStatusHealthy   HealthStatus = "healthy"
+	HealthStatusDegraded  HealthStatus = "degraded"
+	HealthStatusUnhealthy HealthStatus = "unhealthy"
+)
+
+type HealthResult struct {
+	Status  HealthStatus
+	Details map[string]any
+	Err     error
+}
+
+type ModuleHealth struct {
+	Name      string         `json:"name"`
+	Status    HealthStatus   `json:"status"`
+	Critical  bool           `json:"critical"`
+	Latency   time.Duration  `json:"latency"`
+	Error     string         `json:"error,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+	CheckedAt time.Time      `json:"checked_at"`
+	err       error
+}
+
+type HealthReport struct {
+	Status    HealthStatus   `json:"status"`
+	Modules   []ModuleHealth `json:"modules"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+func (a *Application) HealthReport(ctx context.Context) HealthReport {
+	if report, ok := a.healthMonitor.cached(); ok {
+		return report
+	}
+	return a.checkAll(ctx)
+}
+
+func (a *Application) WatchHealth() (<-chan HealthTransition, func()) {
+	return a.healthMonitor.watch.subscribe()
+}
+
+func (a *Application) HealthHistory(module string) []HealthTransition {
+	return a.healthMonitor.transitions(module)
+}
+
+func (a *Application) checkAll(ctx context.Context) HealthReport {
+	var modules []Module
+	for _, m := range a.registry.getAll() {
+		if isHealthChecker(m) {
+			modules = append(modules, m)
+		}
+	}
+
+	results := make([]ModuleHealth, len(modules))
+	var wg sync.WaitGroup
+	for i, m := range modules {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = a.checkHealth(ctx, m)
+		}()
+	}
+	wg.Wait()
+
+	return newHealthReport(results)
+}
+
+func (a *Application) Health(ctx context.Context) error {
+	var errs []error
+	for _, m := range a.HealthReport(ctx).Modules {
+		if m.Critical && m.Status == HealthStatusUnhealthy {
+			errs = append(errs, moduleError(m.Name, PhaseHealth, m.err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (a *Application) checkHealth(ctx context.Context, m Module) ModuleHealth {
+	if a.healthTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.healthTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	resultCh := make(chan HealthResult, 1)
+	go func() {
+		resultCh <- a.interceptHealthCheck(ctx, m)
+	}()
+
+	var result HealthResult
+	select {
+	case result = <-resultCh:
+	case <-ctx.Done():
+		result = HealthResult{Err: fmt.Errorf("health check aborted: %w", ctx.Err())}
+	}
+	result = normalizeHealthResult(result)
+
+	_, nonCritical := a.nonCritical[m.Name()]
+	health := ModuleHealth{
+		Name:      m.Name(),
+		Status:    result.Status,
+		Critical:  !nonCritical,
+		Latency:   time.Since(start),
+		Details:   result.Details,
+		CheckedAt: start,
+		err:       result.Err,
+	}
+	if result.Err != nil {
+		health.Error = result.Err.Error()
+	}
+	return health
+}
+
+func isHealthChecker(m Module) bool {
+	switch m.(type) {
+	case DetailedHealthChecker, HealthChecker:
+		return true
+	default:
+		return false
+	}
+}
+
+func (a *Application) interceptHealthCheck(ctx context.Context, m Module) HealthResult {
+	var result HealthResult
+	err := a.runner.intercept(ctx, PhaseHealth, m, func(ctx context.Context) error {
+		result = runHealthCheck(ctx, m)
+		return result.Err
+	})
+	failed := result.Err != nil || result.Status == HealthStatusDegraded || result.Status == HealthStatusUnhealthy
+	switch {
+	case err == result.Err:
+		return result
+	case err == nil:
+		return HealthResult{Details: result.Details}
+	case failed:
+		result.Err = err
+		return result
+	default:
+		return HealthResult{Status: HealthStatusUnhealthy, Details: result.Details, Err: err}
+	}
+}
+
+func runHealthCheck(ctx context.Context, m Module) HealthResult {
+	switch hc := m.(type) {
+	case DetailedHealthChecker:
+		return hc.CheckHealth(ctx)
+	case HealthChecker:
+		return HealthResult{Err: hc.Health(ctx)}
+	default:
+		return HealthResult{Status: HealthStatusHealthy}
+	}
+}
+
+func normalizeHealthResult(result HealthResult) HealthResult {
+	if result.Status == "" {
+		result.Status = HealthStatusHealthy
+		if result.Err != nil {
+			result.Status = HealthStatusUnhealthy
+		}
+	}
+	if result.Status == HealthStatusUnhealthy && result.Err == nil {
+		result.Err = ErrModuleUnhealthy
+	}
+	return result
+}
+
+func newHealthReport(modules []ModuleHealth) HealthReport {
+	report := HealthReport{
+		Status:    HealthStatusHealthy,
+		Modules:   modules,
+		Timestamp: time.Now(),
+	}
+	for _, m := range modules {
+		status := m.Status
+		if status == HealthStatusUnhealthy && !m.Critical {
+			status = HealthStatusDegraded
+		}
+		report.Status = worseHealthStatus(report.Status, status)
+	}
+	return report
+}
+
+func worseHealthStatus(a, b HealthStatus) HealthStatus {
+	if healthSeverity(b) > healthSeverity(a) {
+		return b
+	}
+	return a
+}
+
+func healthSeverity(s HealthStatus) int {
+	switch s {
+	case HealthStatusUnhealthy:
+		return 2
+	case HealthStatusDegraded:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+type probeResponse struct {
+	Status    string         `json:"status"`
+	Ready     *bool          `json:"ready,omitempty"`
+	Modules   []ModuleHealth `json:"modules,omitempty"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+type healthHandler struct {
+	app *Application
+}
+
+func HealthHandler(a *Application) http.Handler {
+	return &healthHandler{app: a}
+}
+
+func (h *healthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch path.Base(r.URL.Path) {
+	case "livez":
+		h.serveLive(w, r)
+	case "readyz":
+		h.serveReady(w, r)
+	case "healthz":
+		h.serveHealth(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *healthHandler) serveLive(w http.ResponseWriter, r *http.Request) {
+	writeProbe(w, r, http.StatusOK, probeResponse{Status: "ok", Timestamp: time.Now()}, []string{"[+]ping ok"})
+}
+
+func (h *healthHandler) serveReady(w http.ResponseWriter, r *http.Request) {
+	ready := h.app.State() == StateRunning
+	if !ready {
+		resp := probeResponse{Status: "not_ready", Ready: &ready, Timestamp: time.Now()}
+		writeProbe(w, r, http.StatusServiceUnavailable, resp, []string{"[-]lifecycle not ready"})
+		return
+	}
+
+	report := h.app.HealthReport(r.Context())
+	code := http.StatusOK
+	status := "ready"
+	if report.Status == HealthStatusUnhealthy {
+		code = http.StatusServiceUnavailable
+		status = "not_ready"
+	}
+
+	resp := probeResponse{Status: status, Ready: &ready, Timestamp: report.Timestamp}
+	if isVerbose(r) {
+		resp.Modules = report.Modules
+	}
+	writeProbe(w, r, code, resp, append([]string{"[+]lifecycle ok"}, moduleLines(report.Modules)...))
+}
+
+func (h *healthHandler) serveHealth(w http.ResponseWriter, r *http.Request) {
+	report := h.app.HealthReport(r.Context())
+	code := http.StatusOK
+	if report.Status == HealthStatusUnhealthy {
+		code = http.StatusServiceUnavailable
+	}
+
+	resp := probeResponse{Status: string(report.Status), Timestamp: report.Timestamp}
+	if isVerbose(r) {
+		resp.Modules = report.Modules
+	}
+	writeProbe(w, r, code, resp, moduleLines(report.Modules))
+}
+
+func moduleLines(modules []ModuleHealth) []string {
+	lines := make([]string, 0, len(modules))
+	for _, m := range modules {
+		switch {
+		case m.Status == HealthStatusUnhealthy && m.Critical:
+			lines = append(lines, fmt.Sprintf("[-]%s failed: %s", m.Name, m.Error))
+		case m.Status == HealthStatusUnhealthy:
+			lines = append(lines, fmt.Sprintf("[!]%s failed (non-critical): %s", m.Name, m.Error))
+		case m.Status == HealthStatusDegraded && m.Error != "":
+			lines = append(lines, fmt.Sprintf("[!]%s degraded: %s", m.Name, m.Error))
+		case m.Status == HealthStatusDegraded:
+			lines = append(lines, fmt.Sprintf("[!]%s degraded", m.Name))
+		default:
+			lines = append(lines, fmt.Sprintf("[+]%s ok", m.Name))
+		}
+	}
+	return lines
+}
+
+func writeProbe(w http.ResponseWriter, r *http.Request, code int, resp probeResponse, lines []string) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	if wantsJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(resp)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+
+	var b strings.Builder
+	if isVerbose(r) {
+		for _, line := range lines {
+			b.WriteString(line)
+			b.WriteByte('\n')
+		}
+	}
+	b.WriteString(resp.Status)
+	b.WriteByte('\n')
+	_, _ = w.Write([]byte(b.String()))
+}
+
+func wantsJSON(r *http.Request) bool {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return format == "json"
+	}
+	return strings.Contains(r.Header.Get("Accept"), "application/json")
+}
+
+func isVerbose(r *http.Request) bool {
+	q := r.URL.Query()
+	if !q.Has("verbose") {
+		return false
+	}
+	v := q.Get("verbose")
+	return v != "false" && v != "0"
+}
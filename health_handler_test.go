@@ -0,0 +1,188 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func serveProbe(t *testing.T, a *Application, method, target string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, target, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	HealthHandler(a).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHealthHandler_Livez(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	rec := serveProbe(t, a, http.MethodGet, "/livez", nil)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", rec.Code)
+	}
+	if strings.TrimSpace(rec.Body.String()) != "ok" {
+		t.Errorf("expected ok body, got %q", rec.Body.String())
+	}
+}
+
+func TestHealthHandler_Readyz_NotStarted(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	rec := serveProbe(t, a, http.MethodGet, "/readyz", nil)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", rec.Code)
+	}
+}
+
+func TestHealthHandler_Readyz_Ready(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "db"}})
+	a.states.app = StateRunning
+	rec := serveProbe(t, a, http.MethodGet, "/readyz?verbose", nil)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "[+]lifecycle ok") || !strings.Contains(body, "[+]db ok") {
+		t.Errorf("expected verbose module lines, got %q", body)
+	}
+}
+
+func TestHealthHandler_Readyz_Unhealthy(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "db"},
+		healthFn:   func(ctx context.Context) error { return errTest },
+	})
+	a.states.app = StateRunning
+	rec := serveProbe(t, a, http.MethodGet, "/readyz", nil)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", rec.Code)
+	}
+}
+
+func TestHealthHandler_Healthz_JSON(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "db"},
+		healthFn:   func(ctx context.Context) error { return errTest },
+	})
+	rec := serveProbe(t, a, http.MethodGet, "/healthz?verbose=1", http.Header{"Accept": {"application/json"}})
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("expected json content type, got %q", ct)
+	}
+	var resp probeResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if resp.Status != string(HealthStatusUnhealthy) {
+		t.Errorf("expected unhealthy status, got %q", resp.Status)
+	}
+	if len(resp.Modules) != 1 || resp.Modules[0].Error != errTest.Error() {
+		t.Errorf("expected module details, got %+v", resp.Modules)
+	}
+}
+
+func TestHealthHandler_Healthz_NonVerboseOmitsModules(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "db"}})
+	rec := serveProbe(t, a, http.MethodGet, "/healthz?format=json", nil)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", rec.Code)
+	}
+	var resp probeResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if len(resp.Modules) != 0 {
+		t.Errorf("expected modules to be omitted, got %+v", resp.Modules)
+	}
+}
+
+func TestHealthHandler_Prefixed(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	rec := serveProbe(t, a, http.MethodGet, "/internal/livez", nil)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", rec.Code)
+	}
+}
+
+func TestHealthHandler_NotFound(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	rec := serveProbe(t, a, http.MethodGet, "/metrics", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", rec.Code)
+	}
+}
+
+func TestHealthHandler_MethodNotAllowed(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	rec := serveProbe(t, a, http.MethodPost, "/healthz", nil)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405, got %d", rec.Code)
+	}
+}
+
+func TestHealthHandler_ReadinessFollowsLifecycle(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	var duringStart, afterStart int
+	_ = a.Register(&mockModule{name: "m1", startFn: func(ctx context.Context) error {
+		duringStart = serveProbe(t, a, http.MethodGet, "/readyz", nil).Code
+		return nil
+	}})
+	a.hooks = append(a.hooks, Hook{AfterStart: func(ctx context.Context) error {
+		afterStart = serveProbe(t, a, http.MethodGet, "/readyz", nil).Code
+		return nil
+	}})
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	_ = a.Run(ctx)
+	if duringStart != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 during start, got %d", duringStart)
+	}
+	if afterStart != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 before after start hooks complete, got %d", afterStart)
+	}
+	if code := serveProbe(t, a, http.MethodGet, "/readyz", nil).Code; code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 after shutdown, got %d", code)
+	}
+}
+
+func TestHealthHandler_Degraded(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithNonCriticalModules("cache"))
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "cache"},
+		healthFn:   func(ctx context.Context) error { return errTest },
+	})
+	a.states.app = StateRunning
+	if code := serveProbe(t, a, http.MethodGet, "/readyz", nil).Code; code != http.StatusOK {
+		t.Errorf("expected readyz 200 when degraded, got %d", code)
+	}
+	rec := serveProbe(t, a, http.MethodGet, "/healthz?verbose", nil)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected healthz 200 when degraded, got %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "[!]cache failed (non-critical)") || !strings.HasSuffix(body, "degraded\n") {
+		t.Errorf("expected degraded report, got %q", body)
+	}
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+type HealthTransition struct {
+	Module string       `json:"module"`
+	From   HealthStatus `json:"from"`
+	To     HealthStatus `json:"to"`
+	Error  string       `json:"error,omitempty"`
+	Time   time.Time    `json:"time"`
+}
+
+type healthMonitor struct {
+	check       func(ctx context.Context) HealthReport
+	notify      func(ctx context.Context, transition HealthTransition)
+	logger      Logger
+	interval    time.Duration
+	jitter      time.Duration
+	historySize int
+
+	mu      sync.RWMutex
+	report  *HealthReport
+	history map[string][]HealthTransition
+	watch   broadcaster[HealthTransition]
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (m *healthMonitor) enabled() bool {
+	return m.interval > 0
+}
+
+func (m *healthMonitor) start(ctx context.Context) {
+	if !m.enabled() {
+		return
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	m.poll(ctx)
+
+	go func() {
+		defer close(m.done)
+		timer := time.NewTimer(m.nextDelay())
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				m.poll(ctx)
+				timer.Reset(m.nextDelay())
+			}
+		}
+	}()
+}
+
+func (m *healthMonitor) stop() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	<-m.done
+
+	m.mu.Lock()
+	m.report = nil
+	m.mu.Unlock()
+}
+
+func (m *healthMonitor) nextDelay() time.Duration {
+	if m.jitter <= 0 {
+		return m.interval
+	}
+	return m.interval + rand.N(m.jitter+1)
+}
+
+func (m *healthMonitor) cached() (HealthReport, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.report == nil {
+		return HealthReport{}, false
+	}
+	return *m.report, true
+}
+
+func (m *healthMonitor) transitions(module string) []HealthTransition {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	result := make([]HealthTransition, len(m.history[module]))
+	copy(result, m.history[module])
+	return result
+}
+
+func (m *healthMonitor) poll(ctx context.Context) {
+	report := m.check(ctx)
+
+	m.mu.Lock()
+	previous := make(map[string]HealthStatus)
+	if m.report != nil {
+		for _, mh := range m.report.Modules {
+			previous[mh.Name] = mh.Status
+		}
+	}
+
+	var changes []HealthTransition
+	for _, mh := range report.Modules {
+		from, seen := previous[mh.Name]
+		if from == mh.Status || (!seen && mh.Status == HealthStatusHealthy) {
+			continue
+		}
+		transition := HealthTransition{
+			Module: mh.Name,
+			From:   from,
+			To:     mh.Status,
+			Error:  mh.Error,
+			Time:   mh.CheckedAt,
+		}
+		m.record(transition)
+		changes = append(changes, transition)
+	}
+	m.report = &report
+	m.mu.Unlock()
+
+	for _, transition := range changes {
+		if transition.To == HealthStatusHealthy {
+			m.logger.Info("module health changed", "module", transition.Module, "from", transition.From, "to", transition.To)
+		} else {
+			m.logger.Error("module health changed", "module", transition.Module, "from", transition.From, "to", transition.To, "error", transition.Error)
+		}
+		m.watch.publish(transition)
+		m.notify(ctx, transition)
+	}
+}
+
+func (m *healthMonitor) record(transition HealthTransition) {
+	if m.history == nil {
+		m.history = make(map[string][]HealthTransition)
+	}
+	history := append(m.history[transition.Module], transition)
+	if len(history) > m.historySize {
+		history = history[len(history)-m.historySize:]
+	}
+	m.history[transition.Module] = history
+}
@@ -0,0 +1,215 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestHealthMonitor(reports ...HealthReport) (*healthMonitor, *atomic.Int32) {
+	var calls atomic.Int32
+	m := &healthMonitor{
+		logger:      &noopLogger{},
+		interval:    time.Hour,
+		historySize: 2,
+		notify:      func(ctx context.Context, transition HealthTransition) {},
+	}
+	m.check = func(ctx context.Context) HealthReport {
+		n := int(calls.Add(1)) - 1
+		if n >= len(reports) {
+			n = len(reports) - 1
+		}
+		return reports[n]
+	}
+	return m, &calls
+}
+
+func reportWith(statuses ...HealthStatus) HealthReport {
+	modules := make([]ModuleHealth, len(statuses))
+	for i, s := range statuses {
+		modules[i] = ModuleHealth{Name: string(rune('a' + i)), Status: s, Critical: true}
+	}
+	return newHealthReport(modules)
+}
+
+func TestHealthMonitor_Disabled(t *testing.T) {
+	t.Parallel()
+	m, calls := newTestHealthMonitor(reportWith(HealthStatusHealthy))
+	m.interval = 0
+	m.start(context.Background())
+	m.stop()
+	if calls.Load() != 0 {
+		t.Errorf("expected no checks, got %d", calls.Load())
+	}
+	if _, ok := m.cached(); ok {
+		t.Error("expected no cached report")
+	}
+}
+
+func TestHealthMonitor_CachesReport(t *testing.T) {
+	t.Parallel()
+	m, calls := newTestHealthMonitor(reportWith(HealthStatusUnhealthy))
+	m.start(context.Background())
+	defer m.stop()
+	report, ok := m.cached()
+	if !ok || report.Status != HealthStatusUnhealthy {
+		t.Errorf("expected cached unhealthy report, got %+v", report)
+	}
+	_, _ = m.cached()
+	if calls.Load() != 1 {
+		t.Errorf("expected a single check, got %d", calls.Load())
+	}
+}
+
+func TestHealthMonitor_StopClearsCache(t *testing.T) {
+	t.Parallel()
+	m, _ := newTestHealthMonitor(reportWith(HealthStatusHealthy))
+	m.start(context.Background())
+	m.stop()
+	if _, ok := m.cached(); ok {
+		t.Error("expected cache to be cleared after stop")
+	}
+}
+
+func TestHealthMonitor_PollsOnInterval(t *testing.T) {
+	t.Parallel()
+	m, calls := newTestHealthMonitor(reportWith(HealthStatusHealthy))
+	m.interval = 5 * time.Millisecond
+	m.jitter = 5 * time.Millisecond
+	m.start(context.Background())
+	time.Sleep(60 * time.Millisecond)
+	m.stop()
+	if calls.Load() < 3 {
+		t.Errorf("expected several polls, got %d", calls.Load())
+	}
+}
+
+func TestHealthMonitor_Transitions(t *testing.T) {
+	t.Parallel()
+	m, _ := newTestHealthMonitor(
+		reportWith(HealthStatusHealthy),
+		reportWith(HealthStatusUnhealthy),
+		reportWith(HealthStatusDegraded),
+		reportWith(HealthStatusDegraded),
+		reportWith(HealthStatusHealthy),
+	)
+	var mu sync.Mutex
+	var notified []HealthTransition
+	m.notify = func(ctx context.Context, transition HealthTransition) {
+		mu.Lock()
+		defer mu.Unlock()
+		notified = append(notified, transition)
+	}
+	ch, cancel := m.watch.subscribe()
+	defer cancel()
+
+	for range 5 {
+		m.poll(context.Background())
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(notified) != 3 {
+		t.Fatalf("expected 3 transitions, got %+v", notified)
+	}
+	if notified[0].From != HealthStatusHealthy || notified[0].To != HealthStatusUnhealthy {
+		t.Errorf("unexpected first transition: %+v", notified[0])
+	}
+	if len(ch) != 3 {
+		t.Errorf("expected 3 watched transitions, got %d", len(ch))
+	}
+	history := m.transitions("a")
+	if len(history) != 2 {
+		t.Fatalf("expected history trimmed to 2, got %+v", history)
+	}
+	if history[0].To != HealthStatusDegraded || history[1].To != HealthStatusHealthy {
+		t.Errorf("expected last two transitions, got %+v", history)
+	}
+}
+
+func TestHealthMonitor_InitialUnhealthyIsTransition(t *testing.T) {
+	t.Parallel()
+	m, _ := newTestHealthMonitor(reportWith(HealthStatusHealthy, HealthStatusUnhealthy))
+	m.poll(context.Background())
+	if len(m.transitions("a")) != 0 {
+		t.Errorf("expected no transition for initially healthy module")
+	}
+	history := m.transitions("b")
+	if len(history) != 1 || history[0].From != "" || history[0].To != HealthStatusUnhealthy {
+		t.Errorf("expected initial unhealthy transition, got %+v", history)
+	}
+}
+
+func TestApplication_HealthMonitor_ServesCachedReport(t *testing.T) {
+	t.Parallel()
+	var checks atomic.Int32
+	var transitions atomic.Int32
+	a := newTestApp(
+		WithHealthMonitor(time.Hour, 0, 10),
+		WithHook(Hook{OnHealthChange: func(ctx context.Context, transition HealthTransition) {
+			transitions.Add(1)
+		}}),
+	)
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "db"},
+		healthFn: func(ctx context.Context) error {
+			checks.Add(1)
+			return errTest
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- a.Run(ctx) }()
+	waitFor(t, isRunning(a))
+	for range 5 {
+		_ = a.Health(context.Background())
+	}
+	cancel()
+	<-done
+	if checks.Load() != 1 {
+		t.Errorf("expected a single polled check, got %d", checks.Load())
+	}
+	if transitions.Load() != 1 {
+		t.Errorf("expected health change hook to fire once, got %d", transitions.Load())
+	}
+	if len(a.HealthHistory("db")) != 1 {
+		t.Errorf("expected recorded transition, got %+v", a.HealthHistory("db"))
+	}
+}
+
+func TestApplication_WatchHealth(t *testing.T) {
+	t.Parallel()
+	var checks atomic.Int32
+	a := newTestApp(WithHealthMonitor(10*time.Millisecond, 0, 10))
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "db"},
+		healthFn: func(ctx context.Context) error {
+			if checks.Add(1) > 1 {
+				return errTest
+			}
+			return nil
+		},
+	})
+	ch, unsubscribe := a.WatchHealth()
+	cancel, errCh := runInBackground(a)
+	defer func() { cancel(); <-errCh }()
+
+	select {
+	case transition := <-ch:
+		if transition.Module != "db" || transition.From != HealthStatusHealthy || transition.To != HealthStatusUnhealthy {
+			t.Errorf("unexpected transition: %+v", transition)
+		}
+		if transition.Error == "" {
+			t.Error("expected transition error")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected health transition")
+	}
+
+	unsubscribe()
+	unsubscribe()
+	for range ch {
+	}
+}
@@ -0,0 +1,181 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestApplication_HealthReport_NoModules(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "plain"})
+	report := a.HealthReport(context.Background())
+	if report.Status != HealthStatusHealthy {
+		t.Errorf("expected healthy, got %s", report.Status)
+	}
+	if len(report.Modules) != 0 {
+		t.Errorf("expected no module results, got %d", len(report.Modules))
+	}
+	if report.Timestamp.IsZero() {
+		t.Error("expected timestamp to be set")
+	}
+}
+
+func TestApplication_HealthReport_PerModule(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "ok"}})
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "sick"},
+		healthFn:   func(ctx context.Context) error { return errTest },
+	})
+	report := a.HealthReport(context.Background())
+	if report.Status != HealthStatusUnhealthy {
+		t.Errorf("expected unhealthy, got %s", report.Status)
+	}
+	if len(report.Modules) != 2 {
+		t.Fatalf("expected 2 module results, got %d", len(report.Modules))
+	}
+	if m := report.Modules[0]; m.Name != "ok" || m.Status != HealthStatusHealthy || m.Error != "" {
+		t.Errorf("unexpected result for ok: %+v", m)
+	}
+	if m := report.Modules[1]; m.Name != "sick" || m.Status != HealthStatusUnhealthy || m.Error != errTest.Error() {
+		t.Errorf("unexpected result for sick: %+v", m)
+	}
+	if report.Modules[0].CheckedAt.IsZero() {
+		t.Error("expected checked at to be set")
+	}
+}
+
+func TestApplication_HealthReport_Concurrent(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	slow := func(ctx context.Context) error {
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	}
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "h1"}, healthFn: slow})
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "h2"}, healthFn: slow})
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "h3"}, healthFn: slow})
+	start := time.Now()
+	report := a.HealthReport(context.Background())
+	if elapsed := time.Since(start); elapsed > 120*time.Millisecond {
+		t.Errorf("expected checks to run concurrently, took %v", elapsed)
+	}
+	for _, m := range report.Modules {
+		if m.Latency < 50*time.Millisecond {
+			t.Errorf("expected latency >= 50ms for %s, got %v", m.Name, m.Latency)
+		}
+	}
+}
+
+func TestApplication_HealthReport_Timeout(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithHealthTimeout(20 * time.Millisecond))
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "hung"},
+		healthFn: func(ctx context.Context) error {
+			time.Sleep(200 * time.Millisecond)
+			return nil
+		},
+	})
+	start := time.Now()
+	report := a.HealthReport(context.Background())
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("expected check to be abandoned after timeout, took %v", elapsed)
+	}
+	if report.Status != HealthStatusUnhealthy {
+		t.Errorf("expected unhealthy, got %s", report.Status)
+	}
+	if !errors.Is(report.Modules[0].err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", report.Modules[0].err)
+	}
+}
+
+func TestApplication_Health_WrapsModuleErrors(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "sick"},
+		healthFn:   func(ctx context.Context) error { return errTest },
+	})
+	err := a.Health(context.Background())
+	if !errors.Is(err, errTest) {
+		t.Errorf("expected errTest, got %v", err)
+	}
+}
+
+func TestApplication_HealthReport_DetailedChecker(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockDetailedHealthModule{
+		mockModule: mockModule{name: "cache"},
+		result: HealthResult{
+			Status:  HealthStatusDegraded,
+			Details: map[string]any{"hit_ratio": 0.1},
+		},
+	})
+	report := a.HealthReport(context.Background())
+	if report.Status != HealthStatusDegraded {
+		t.Errorf("expected degraded, got %s", report.Status)
+	}
+	m := report.Modules[0]
+	if m.Status != HealthStatusDegraded || m.Details["hit_ratio"] != 0.1 {
+		t.Errorf("unexpected module result: %+v", m)
+	}
+	if err := a.Health(context.Background()); err != nil {
+		t.Errorf("expected degraded application to pass Health, got %v", err)
+	}
+}
+
+func TestApplication_HealthReport_DetailedUnhealthyWithoutError(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockDetailedHealthModule{
+		mockModule: mockModule{name: "db"},
+		result:     HealthResult{Status: HealthStatusUnhealthy},
+	})
+	err := a.Health(context.Background())
+	if !errors.Is(err, ErrModuleUnhealthy) {
+		t.Errorf("expected ErrModuleUnhealthy, got %v", err)
+	}
+}
+
+func TestApplication_HealthReport_NonCriticalFailureDegrades(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithNonCriticalModules("cache"))
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "db"}})
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "cache"},
+		healthFn:   func(ctx context.Context) error { return errTest },
+	})
+	report := a.HealthReport(context.Background())
+	if report.Status != HealthStatusDegraded {
+		t.Errorf("expected degraded, got %s", report.Status)
+	}
+	if m := report.Modules[1]; m.Critical || m.Status != HealthStatusUnhealthy {
+		t.Errorf("expected non-critical unhealthy cache, got %+v", m)
+	}
+	if err := a.Health(context.Background()); err != nil {
+		t.Errorf("expected nil error when only non-critical modules fail, got %v", err)
+	}
+}
+
+func TestApplication_HealthReport_CriticalFailureWins(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithNonCriticalModules("cache"))
+	_ = a.Register(&mockHealthModule{
+		mockModule: mockModule{name: "db"},
+		healthFn:   func(ctx context.Context) error { return errTest },
+	})
+	_ = a.Register(&mockDetailedHealthModule{
+		mockModule: mockModule{name: "cache"},
+		result:     HealthResult{Status: HealthStatusDegraded},
+	})
+	report := a.HealthReport(context.Background())
+	if report.Status != HealthStatusUnhealthy {
+		t.Errorf("expected unhealthy, got %s", report.Status)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"testing"
 	"time"
 )
 
@@ -12,9 +13,11 @@ type mockModule struct {
 	initFn  func(ctx context.Context) error
 	startFn func(ctx context.Context) error
 	stopFn  func(ctx context.Context) error
+	deps    []string
 }
 
-func (m *mockModule) Name() string { return m.name }
+func (m *mockModule) Name() string        { return m.name }
+func (m *mockModule) DependsOn() []string { return m.deps }
 func (m *mockModule) Init(ctx context.Context) error {
 	if m.initFn != nil {
 		return m.initFn(ctx)
@@ -88,4 +91,103 @@ func newTestApp(opts ...Option) *Application {
 	return a
 }
 
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func isRunning(a *Application) func() bool {
+	return func() bool { return a.State() == StateRunning }
+}
+
+func runInBackground(a *Application) (context.CancelFunc, <-chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- a.Run(ctx) }()
+	return cancel, done
+}
+
 var errTest = errors.New("test error")
+
+type mockDetailedHealthModule struct {
+	mockModule
+	result HealthResult
+}
+
+func (m *mockDetailedHealthModule) CheckHealth(ctx context.Context) HealthResult {
+	return m.result
+}
+
+type mockCleanerModule struct {
+	mockModule
+	cleanupFn func(ctx context.Context) error
+}
+
+func (m *mockCleanerModule) Cleanup(ctx context.Context) error {
+	if m.cleanupFn != nil {
+		return m.cleanupFn(ctx)
+	}
+	return nil
+}
+
+type mockStopTimeoutModule struct {
+	mockModule
+	timeout time.Duration
+}
+
+func (m *mockStopTimeoutModule) StopTimeout() time.Duration {
+	return m.timeout
+}
+
+type mockForceStopModule struct {
+	mockModule
+	forceStopFn func(ctx context.Context) error
+}
+
+func (m *mockForceStopModule) ForceStop(ctx context.Context) error {
+	if m.forceStopFn != nil {
+		return m.forceStopFn(ctx)
+	}
+	return nil
+}
+
+type mockReloaderModule struct {
+	mockModule
+	reloadFn func(ctx context.Context) error
+}
+
+func (m *mockReloaderModule) Reload(ctx context.Context) error {
+	if m.reloadFn != nil {
+		return m.reloadFn(ctx)
+	}
+	return nil
+}
+
+type mockStartTimeoutModule struct {
+	mockModule
+	timeout time.Duration
+}
+
+func (m *mockStartTimeoutModule) StartTimeout() time.Duration {
+	return m.timeout
+}
+
+type mockReadyModule struct {
+	mockModule
+	ready chan struct{}
+}
+
+func (m *mockReadyModule) Ready() <-chan struct{} {
+	return m.ready
+}
+
+type mockSignal struct{}
+
+func (mockSignal) String() string { return "mock" }
+func (mockSignal) Signal()        {}
@@ -7,4 +7,9 @@ type Hook struct {
 	AfterStart  func(ctx context.Context) error
 	BeforeStop  func(ctx context.Context) error
 	AfterStop   func(ctx context.Context) error
+
+	BeforeReload func(ctx context.Context) error
+	AfterReload  func(ctx context.Context) error
+
+	OnHealthChange func(ctx context.Context, transition HealthTransition)
 }
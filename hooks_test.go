@@ -147,3 +147,21 @@ func TestRunHooks_NoHooks(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestRunHooksReload(t *testing.T) {
+	t.Parallel()
+	var called []string
+	a := newTestApp(WithHook(Hook{}), WithHook(Hook{
+		BeforeReload: func(ctx context.Context) error { called = append(called, "before"); return nil },
+		AfterReload:  func(ctx context.Context) error { called = append(called, "after"); return errTest },
+	}))
+	if err := a.runHooksBeforeReload(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := a.runHooksAfterReload(context.Background()); err != errTest {
+		t.Errorf("expected errTest, got %v", err)
+	}
+	if len(called) != 2 {
+		t.Errorf("expected both hooks to be called, got %v", called)
+	}
+}
@@ -0,0 +1,32 @@
+package app
+
+import "context"
+
+type Phase string
+
+const (
+	PhaseInit       Phase = "init"
+	PhaseStart      Phase = "start"
+	PhaseStop       Phase = "stop"
+	PhaseCleanup    Phase = "cleanup"
+	PhaseForceStop  Phase = "force stop"
+	PhaseReload     Phase = "reload"
+	PhaseHealth     Phase = "health"
+	PhaseBackground Phase = "background"
+	PhaseHook       Phase = "hook"
+)
+
+type Interceptor func(ctx context.Context, phase Phase, module Module, next func(ctx context.Context) error) error
+
+func (r *runner) intercept(ctx context.Context, phase Phase, m Module, call func(ctx context.Context) error) (err error) {
+	defer recoverPanic(m.Name(), phase, &err)
+
+	next := call
+	for i := len(r.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := r.interceptors[i], next
+		next = func(ctx context.Context) error {
+			return interceptor(ctx, phase, m, inner)
+		}
+	}
+	return next(ctx)
+}
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRunner_Intercept_Order(t *testing.T) {
+	t.Parallel()
+	var calls []string
+	wrap := func(name string) Interceptor {
+		return func(ctx context.Context, phase Phase, m Module, next func(ctx context.Context) error) error {
+			calls = append(calls, name+" before "+string(phase)+" "+m.Name())
+			err := next(ctx)
+			calls = append(calls, name+" after")
+			return err
+		}
+	}
+	r := newTestRunner()
+	r.interceptors = []Interceptor{wrap("outer"), wrap("inner")}
+	err := r.intercept(context.Background(), PhaseInit, &mockModule{name: "m"}, func(ctx context.Context) error {
+		calls = append(calls, "call")
+		return errTest
+	})
+	if !errors.Is(err, errTest) {
+		t.Errorf("expected errTest, got %v", err)
+	}
+	expected := "outer before init m,inner before init m,call,inner after,outer after"
+	if strings.Join(calls, ",") != expected {
+		t.Errorf("expected %q, got %q", expected, strings.Join(calls, ","))
+	}
+}
+
+func TestApplication_Interceptor_LifecyclePhases(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var phases []string
+	a := newTestApp(WithInterceptor(func(ctx context.Context, phase Phase, m Module, next func(ctx context.Context) error) error {
+		mu.Lock()
+		phases = append(phases, string(phase)+":"+m.Name())
+		mu.Unlock()
+		return next(ctx)
+	}))
+	_ = a.Register(&mockModule{name: "m"})
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	if err := a.Run(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(phases, ",") != "init:m,start:m,stop:m" {
+		t.Errorf("unexpected phases: %v", phases)
+	}
+}
+
+func TestApplication_Interceptor_FaultInjection(t *testing.T) {
+	t.Parallel()
+	called := false
+	a := newTestApp(WithInterceptor(func(ctx context.Context, phase Phase, m Module, next func(ctx context.Context) error) error {
+		if phase == PhaseStart && m.Name() == "flaky" {
+			return errTest
+		}
+		return next(ctx)
+	}))
+	_ = a.Register(&mockModule{name: "flaky", startFn: func(ctx context.Context) error {
+		called = true
+		return nil
+	}})
+	err := a.Run(context.Background())
+	if !errors.Is(err, errTest) || !strings.Contains(err.Error(), `start module "flaky"`) {
+		t.Errorf("expected injected start error, got %v", err)
+	}
+	if called {
+		t.Error("expected Start to be skipped by interceptor")
+	}
+}
+
+func TestApplication_Interceptor_Health(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithInterceptor(func(ctx context.Context, phase Phase, m Module, next func(ctx context.Context) error) error {
+		err := next(ctx)
+		if phase != PhaseHealth {
+			return err
+		}
+		switch m.Name() {
+		case "swallowed":
+			return nil
+		case "injected":
+			return errTest
+		}
+		if err != nil {
+			return fmt.Errorf("traced: %w", err)
+		}
+		return err
+	}))
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "swallowed"}, healthFn: func(ctx context.Context) error { return errTest }})
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "injected"}})
+	_ = a.Register(&mockDetailedHealthModule{mockModule: mockModule{name: "detailed"}, result: HealthResult{Status: HealthStatusDegraded}})
+	_ = a.Register(&mockDetailedHealthModule{
+		mockModule: mockModule{name: "wrapped"},
+		result:     HealthResult{Status: HealthStatusDegraded, Err: errTest},
+	})
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "failing"}, healthFn: func(ctx context.Context) error { return errTest }})
+
+	statuses := make(map[string]HealthStatus)
+	errs := make(map[string]error)
+	for _, m := range a.HealthReport(context.Background()).Modules {
+		statuses[m.Name] = m.Status
+		errs[m.Name] = m.err
+	}
+	if statuses["swallowed"] != HealthStatusHealthy {
+		t.Errorf("expected swallowed error to be healthy, got %s", statuses["swallowed"])
+	}
+	if statuses["injected"] != HealthStatusUnhealthy {
+		t.Errorf("expected injected error to be unhealthy, got %s", statuses["injected"])
+	}
+	if statuses["detailed"] != HealthStatusDegraded {
+		t.Errorf("expected detailed result to pass through, got %s", statuses["detailed"])
+	}
+	if statuses["wrapped"] != HealthStatusDegraded {
+		t.Errorf("expected wrapped error to keep degraded status, got %s", statuses["wrapped"])
+	}
+	if statuses["failing"] != HealthStatusUnhealthy {
+		t.Errorf("expected wrapped error to keep unhealthy status, got %s", statuses["failing"])
+	}
+	if errs["wrapped"] == nil || !errors.Is(errs["wrapped"], errTest) || !strings.HasPrefix(errs["wrapped"].Error(), "traced: ") {
+		t.Errorf("expected wrapped error to be reported, got %v", errs["wrapped"])
+	}
+}
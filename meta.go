@@ -34,6 +34,9 @@ func (m *meta) enrichContext(ctx context.Context) context.Context {
 }
 
 func (m *meta) uptime() time.Duration {
+	if m.startTime.IsZero() {
+		return 0
+	}
 	end := m.stopTime
 	if end.IsZero() {
 		end = time.Now()
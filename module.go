@@ -1,6 +1,9 @@
 package app
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Module interface {
 	Name() string
@@ -14,6 +17,38 @@ type BackgroundModule interface {
 	Err() <-chan error
 }
 
+type Cleaner interface {
+	Cleanup(ctx context.Context) error
+}
+
+type ReadyNotifier interface {
+	Ready() <-chan struct{}
+}
+
+type StartTimeouter interface {
+	StartTimeout() time.Duration
+}
+
+type StopTimeouter interface {
+	StopTimeout() time.Duration
+}
+
+type ForceStopper interface {
+	ForceStop(ctx context.Context) error
+}
+
+type Reloader interface {
+	Reload(ctx context.Context) error
+}
+
 type HealthChecker interface {
 	Health(ctx context.Context) error
 }
+
+type DetailedHealthChecker interface {
+	CheckHealth(ctx context.Context) HealthResult
+}
+
+type Dependent interface {
+	DependsOn() []string
+}
@@ -1,6 +1,11 @@
 package app
 
-import "time"
+import (
+	"io"
+	"os"
+	"slices"
+	"time"
+)
 
 type Option func(*Application) error
 
@@ -38,6 +43,231 @@ func WithGracefulTimeout(timeout time.Duration) Option {
 	}
 }
 
+func WithHealthTimeout(timeout time.Duration) Option {
+	return func(a *Application) error {
+		if timeout < 0 {
+			return ErrHealthTimeoutNegative
+		}
+		a.healthTimeout = timeout
+		return nil
+	}
+}
+
+func WithHealthMonitor(interval, jitter time.Duration, historySize int) Option {
+	return func(a *Application) error {
+		if interval <= 0 {
+			return ErrHealthIntervalNonPositive
+		}
+		if jitter < 0 {
+			return ErrHealthJitterNegative
+		}
+		if historySize <= 0 {
+			return ErrHealthHistoryNonPositive
+		}
+		a.healthMonitor.interval = interval
+		a.healthMonitor.jitter = jitter
+		a.healthMonitor.historySize = historySize
+		return nil
+	}
+}
+
+func WithNonCriticalModules(names ...string) Option {
+	return func(a *Application) error {
+		if a.nonCritical == nil {
+			a.nonCritical = make(map[string]struct{}, len(names))
+		}
+		for _, name := range names {
+			if name == "" {
+				return ErrModuleNameEmpty
+			}
+			a.nonCritical[name] = struct{}{}
+		}
+		return nil
+	}
+}
+
+func WithParallelLifecycle(maxConcurrency int) Option {
+	return func(a *Application) error {
+		if maxConcurrency < 0 {
+			return ErrMaxConcurrencyNegative
+		}
+		a.parallelLifecycle = true
+		a.maxConcurrency = maxConcurrency
+		return nil
+	}
+}
+
+func WithRestartPolicy(module string, policy RestartPolicy) Option {
+	return func(a *Application) error {
+		if module == "" {
+			return ErrModuleNameEmpty
+		}
+		if err := policy.validate(); err != nil {
+			return err
+		}
+		if a.restartPolicies == nil {
+			a.restartPolicies = make(map[string]RestartPolicy)
+		}
+		a.restartPolicies[module] = policy
+		return nil
+	}
+}
+
+func WithStartupTimeout(timeout time.Duration) Option {
+	return func(a *Application) error {
+		if timeout <= 0 {
+			return ErrStartupTimeoutNonPositive
+		}
+		a.startupTimeout = timeout
+		return nil
+	}
+}
+
+func WithStartTimeout(module string, timeout time.Duration) Option {
+	return func(a *Application) error {
+		if module == "" {
+			return ErrModuleNameEmpty
+		}
+		if timeout <= 0 {
+			return ErrStartTimeoutNonPositive
+		}
+		if a.startTimeouts == nil {
+			a.startTimeouts = make(map[string]time.Duration)
+		}
+		a.startTimeouts[module] = timeout
+		return nil
+	}
+}
+
+func WithReadyTimeout(timeout time.Duration) Option {
+	return func(a *Application) error {
+		if timeout < 0 {
+			return ErrReadyTimeoutNegative
+		}
+		a.readyTimeout = timeout
+		return nil
+	}
+}
+
+func WithStopTimeout(module string, timeout time.Duration) Option {
+	return func(a *Application) error {
+		if module == "" {
+			return ErrModuleNameEmpty
+		}
+		if timeout <= 0 {
+			return ErrStopTimeoutNonPositive
+		}
+		if a.stopTimeouts == nil {
+			a.stopTimeouts = make(map[string]time.Duration)
+		}
+		a.stopTimeouts[module] = timeout
+		return nil
+	}
+}
+
+func WithHardDeadline(deadline time.Duration) Option {
+	return func(a *Application) error {
+		if deadline <= 0 {
+			return ErrHardDeadlineNonPositive
+		}
+		a.hardDeadline = deadline
+		return nil
+	}
+}
+
+func WithShutdownStackDump() Option {
+	return func(a *Application) error {
+		a.dumpStacks = true
+		return nil
+	}
+}
+
+func WithSignals(signals ...os.Signal) Option {
+	return func(a *Application) error {
+		if len(signals) == 0 {
+			return ErrSignalsEmpty
+		}
+		if slices.Contains(signals, nil) {
+			return ErrSignalNil
+		}
+		a.signals = signals
+		return nil
+	}
+}
+
+func WithoutSignalHandling() Option {
+	return func(a *Application) error {
+		a.signalHandling = false
+		return nil
+	}
+}
+
+func WithSignalHandler(sig os.Signal, handler SignalHandler) Option {
+	return func(a *Application) error {
+		if sig == nil {
+			return ErrSignalNil
+		}
+		if handler == nil {
+			return ErrSignalHandlerNil
+		}
+		if a.signalHandlers == nil {
+			a.signalHandlers = make(map[os.Signal]SignalHandler)
+		}
+		a.signalHandlers[sig] = handler
+		return nil
+	}
+}
+
+func WithDiagnosticsSignal(sig os.Signal, w io.Writer) Option {
+	return func(a *Application) error {
+		if sig == nil {
+			return ErrSignalNil
+		}
+		if a.signalHandlers == nil {
+			a.signalHandlers = make(map[os.Signal]SignalHandler)
+		}
+		a.signalHandlers[sig] = a.diagnosticsHandler(w)
+		return nil
+	}
+}
+
+func WithForceExitOnSecondSignal(enabled bool) Option {
+	return func(a *Application) error {
+		a.signalForceExit = enabled
+		return nil
+	}
+}
+
+func WithInterceptor(interceptor Interceptor) Option {
+	return func(a *Application) error {
+		if interceptor == nil {
+			return ErrInterceptorNil
+		}
+		a.interceptors = append(a.interceptors, interceptor)
+		return nil
+	}
+}
+
+func WithExitCodes(codes ExitCodes) Option {
+	return func(a *Application) error {
+		if !codes.valid() {
+			return ErrExitCodeOutOfRange
+		}
+		a.exitCodes = codes
+		return nil
+	}
+}
+
+func WithSupervisionStrategy(strategy SupervisionStrategy) Option {
+	return func(a *Application) error {
+		if strategy < OneForOne || strategy > RestForOne {
+			return ErrInvalidSupervisionStrategy
+		}
+		a.supervision = strategy
+		return nil
+	}
+}
+
 func WithLogger(logger Logger) Option {
 	return func(a *Application) error {
 		if logger != nil {
@@ -3,6 +3,8 @@ package app
 import (
 	"context"
 	"errors"
+	"os"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -113,3 +115,339 @@ func TestWithHook(t *testing.T) {
 		t.Errorf("expected 1 hook, got %d", len(a.hooks))
 	}
 }
+
+func TestWithParallelLifecycle(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithParallelLifecycle(4))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !a.runner.parallel || a.runner.maxConcurrency != 4 {
+		t.Errorf("expected parallel runner with concurrency 4, got %v/%d", a.runner.parallel, a.runner.maxConcurrency)
+	}
+}
+
+func TestWithParallelLifecycle_Negative(t *testing.T) {
+	t.Parallel()
+	_, err := New(WithParallelLifecycle(-1))
+	if !errors.Is(err, ErrMaxConcurrencyNegative) {
+		t.Errorf("expected ErrMaxConcurrencyNegative, got %v", err)
+	}
+}
+
+func TestWithHealthTimeout(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithHealthTimeout(time.Second))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.healthTimeout != time.Second {
+		t.Errorf("expected 1s, got %v", a.healthTimeout)
+	}
+}
+
+func TestWithHealthTimeout_Negative(t *testing.T) {
+	t.Parallel()
+	_, err := New(WithHealthTimeout(-time.Second))
+	if !errors.Is(err, ErrHealthTimeoutNegative) {
+		t.Errorf("expected ErrHealthTimeoutNegative, got %v", err)
+	}
+}
+
+func TestWithNonCriticalModules(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithNonCriticalModules("cache", "metrics"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := a.nonCritical["cache"]; !ok || len(a.nonCritical) != 2 {
+		t.Errorf("expected cache and metrics to be non-critical, got %v", a.nonCritical)
+	}
+}
+
+func TestWithNonCriticalModules_EmptyName(t *testing.T) {
+	t.Parallel()
+	_, err := New(WithNonCriticalModules(""))
+	if !errors.Is(err, ErrModuleNameEmpty) {
+		t.Errorf("expected ErrModuleNameEmpty, got %v", err)
+	}
+}
+
+func TestWithHealthMonitor(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithHealthMonitor(time.Second, 100*time.Millisecond, 5))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := a.healthMonitor
+	if m.interval != time.Second || m.jitter != 100*time.Millisecond || m.historySize != 5 {
+		t.Errorf("unexpected monitor settings: %v %v %d", m.interval, m.jitter, m.historySize)
+	}
+}
+
+func TestWithHealthMonitor_Invalid(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		opt      Option
+		expected error
+	}{
+		{"interval", WithHealthMonitor(0, 0, 1), ErrHealthIntervalNonPositive},
+		{"jitter", WithHealthMonitor(time.Second, -1, 1), ErrHealthJitterNegative},
+		{"history", WithHealthMonitor(time.Second, 0, 0), ErrHealthHistoryNonPositive},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := New(tc.opt); !errors.Is(err, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestWithRestartPolicy(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithRestartPolicy("worker", RestartPolicy{Mode: RestartAlways}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.restartPolicies["worker"].Mode != RestartAlways {
+		t.Errorf("expected policy to be registered, got %+v", a.restartPolicies)
+	}
+}
+
+func TestWithRestartPolicy_Invalid(t *testing.T) {
+	t.Parallel()
+	if _, err := New(WithRestartPolicy("", RestartPolicy{})); !errors.Is(err, ErrModuleNameEmpty) {
+		t.Errorf("expected ErrModuleNameEmpty, got %v", err)
+	}
+	if _, err := New(WithRestartPolicy("w", RestartPolicy{MaxRestarts: -1})); !errors.Is(err, ErrInvalidRestartPolicy) {
+		t.Errorf("expected ErrInvalidRestartPolicy, got %v", err)
+	}
+}
+
+func TestWithSupervisionStrategy(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithSupervisionStrategy(RestForOne))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.supervision != RestForOne {
+		t.Errorf("expected RestForOne, got %v", a.supervision)
+	}
+	if _, err := New(WithSupervisionStrategy(SupervisionStrategy(9))); !errors.Is(err, ErrInvalidSupervisionStrategy) {
+		t.Errorf("expected ErrInvalidSupervisionStrategy, got %v", err)
+	}
+}
+
+func TestWithStopTimeout(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithStopTimeout("db", time.Second))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.runner.stopTimeouts["db"] != time.Second {
+		t.Errorf("expected stop timeout to be passed to runner, got %v", a.runner.stopTimeouts)
+	}
+}
+
+func TestWithStopTimeout_Invalid(t *testing.T) {
+	t.Parallel()
+	if _, err := New(WithStopTimeout("", time.Second)); !errors.Is(err, ErrModuleNameEmpty) {
+		t.Errorf("expected ErrModuleNameEmpty, got %v", err)
+	}
+	if _, err := New(WithStopTimeout("db", 0)); !errors.Is(err, ErrStopTimeoutNonPositive) {
+		t.Errorf("expected ErrStopTimeoutNonPositive, got %v", err)
+	}
+}
+
+func TestWithShutdownStackDump(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithShutdownStackDump())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !a.runner.dumpStacks {
+		t.Error("expected stack dump to be enabled")
+	}
+}
+
+func TestWithHardDeadline(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithHardDeadline(time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.hardDeadline != time.Minute {
+		t.Errorf("expected 1m, got %v", a.hardDeadline)
+	}
+	if _, err := New(WithHardDeadline(0)); !errors.Is(err, ErrHardDeadlineNonPositive) {
+		t.Errorf("expected ErrHardDeadlineNonPositive, got %v", err)
+	}
+}
+
+func TestWithSignals(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithSignals(syscall.SIGHUP))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(a.signals) != 1 || a.signals[0] != syscall.SIGHUP {
+		t.Errorf("expected SIGHUP only, got %v", a.signals)
+	}
+	if _, err := New(WithSignals()); !errors.Is(err, ErrSignalsEmpty) {
+		t.Errorf("expected ErrSignalsEmpty, got %v", err)
+	}
+	if _, err := New(WithSignals(syscall.SIGINT, nil)); !errors.Is(err, ErrSignalNil) {
+		t.Errorf("expected ErrSignalNil, got %v", err)
+	}
+}
+
+func TestWithoutSignalHandling(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithoutSignalHandling())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.signalHandling {
+		t.Error("expected signal handling to be disabled")
+	}
+}
+
+func TestWithSignalHandler(t *testing.T) {
+	t.Parallel()
+	handler := func(ctx context.Context, sig os.Signal) {}
+	a, err := New(WithSignalHandler(syscall.SIGUSR1, handler))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := a.signalHandlers[syscall.SIGUSR1]; !ok {
+		t.Error("expected handler to be registered")
+	}
+	if _, err := New(WithSignalHandler(nil, handler)); !errors.Is(err, ErrSignalNil) {
+		t.Errorf("expected ErrSignalNil, got %v", err)
+	}
+	if _, err := New(WithSignalHandler(syscall.SIGUSR1, nil)); !errors.Is(err, ErrSignalHandlerNil) {
+		t.Errorf("expected ErrSignalHandlerNil, got %v", err)
+	}
+}
+
+func TestWithForceExitOnSecondSignal(t *testing.T) {
+	t.Parallel()
+	a, err := New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !a.signalForceExit {
+		t.Error("expected force exit to be enabled by default")
+	}
+	a, _ = New(WithForceExitOnSecondSignal(false))
+	if a.signalForceExit {
+		t.Error("expected force exit to be disabled")
+	}
+}
+
+func TestWithDiagnosticsSignal(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithDiagnosticsSignal(syscall.SIGQUIT, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := a.signalHandlers[syscall.SIGQUIT]; !ok {
+		t.Error("expected diagnostics handler to be registered")
+	}
+	if _, err := New(WithDiagnosticsSignal(nil, nil)); !errors.Is(err, ErrSignalNil) {
+		t.Errorf("expected ErrSignalNil, got %v", err)
+	}
+}
+
+func TestWithStartupTimeout(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithStartupTimeout(time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.startupTimeout != time.Minute {
+		t.Errorf("expected 1m, got %v", a.startupTimeout)
+	}
+	if _, err := New(WithStartupTimeout(0)); !errors.Is(err, ErrStartupTimeoutNonPositive) {
+		t.Errorf("expected ErrStartupTimeoutNonPositive, got %v", err)
+	}
+}
+
+func TestWithStartTimeout(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithStartTimeout("db", time.Second))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.runner.startTimeouts["db"] != time.Second {
+		t.Errorf("expected start timeout to be passed to runner, got %v", a.runner.startTimeouts)
+	}
+	if _, err := New(WithStartTimeout("", time.Second)); !errors.Is(err, ErrModuleNameEmpty) {
+		t.Errorf("expected ErrModuleNameEmpty, got %v", err)
+	}
+	if _, err := New(WithStartTimeout("db", 0)); !errors.Is(err, ErrStartTimeoutNonPositive) {
+		t.Errorf("expected ErrStartTimeoutNonPositive, got %v", err)
+	}
+}
+
+func TestWithReadyTimeout(t *testing.T) {
+	t.Parallel()
+	a, err := New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.runner.readyTimeout != 30*time.Second {
+		t.Errorf("expected default 30s, got %v", a.runner.readyTimeout)
+	}
+	a, err = New(WithReadyTimeout(0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.runner.readyTimeout != 0 {
+		t.Errorf("expected no ready timeout, got %v", a.runner.readyTimeout)
+	}
+	if _, err := New(WithReadyTimeout(-time.Second)); !errors.Is(err, ErrReadyTimeoutNegative) {
+		t.Errorf("expected ErrReadyTimeoutNegative, got %v", err)
+	}
+}
+
+func TestWithInterceptor(t *testing.T) {
+	t.Parallel()
+	noop := func(ctx context.Context, phase Phase, m Module, next func(ctx context.Context) error) error {
+		return next(ctx)
+	}
+	a, err := New(WithInterceptor(noop), WithInterceptor(noop))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(a.runner.interceptors) != 2 {
+		t.Errorf("expected 2 interceptors, got %d", len(a.runner.interceptors))
+	}
+	if _, err := New(WithInterceptor(nil)); !errors.Is(err, ErrInterceptorNil) {
+		t.Errorf("expected ErrInterceptorNil, got %v", err)
+	}
+}
+
+func TestWithExitCodes(t *testing.T) {
+	t.Parallel()
+	codes := DefaultExitCodes()
+	codes.InitFailure = 3
+	a, err := New(WithExitCodes(codes))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.exitCodes.InitFailure != 3 {
+		t.Errorf("expected init failure code 3, got %d", a.exitCodes.InitFailure)
+	}
+	codes.Signal = 0
+	if _, err := New(WithExitCodes(codes)); !errors.Is(err, ErrExitCodeOutOfRange) {
+		t.Errorf("expected ErrExitCodeOutOfRange, got %v", err)
+	}
+	codes.Signal = 256
+	if _, err := New(WithExitCodes(codes)); !errors.Is(err, ErrExitCodeOutOfRange) {
+		t.Errorf("expected ErrExitCodeOutOfRange, got %v", err)
+	}
+}
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+type PanicError struct {
+	Module string
+	Phase  Phase
+	Value  any
+	Stack  []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+func recoverPanic(module string, phase Phase, err *error) {
+	if v := recover(); v != nil {
+		*err = &PanicError{Module: module, Phase: phase, Value: v, Stack: debug.Stack()}
+	}
+}
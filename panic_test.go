@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPanicError(t *testing.T) {
+	t.Parallel()
+	err := &PanicError{Module: "m", Phase: PhaseInit, Value: "boom"}
+	if err.Error() != "panic: boom" {
+		t.Errorf("unexpected message: %q", err.Error())
+	}
+	if err.Unwrap() != nil {
+		t.Error("expected nil unwrap for non-error value")
+	}
+	wrapped := &PanicError{Value: errTest}
+	if !errors.Is(wrapped, errTest) {
+		t.Error("expected error value to be unwrapped")
+	}
+}
+
+func TestApplication_Run_PanicInInitRollsBack(t *testing.T) {
+	t.Parallel()
+	cleaned := false
+	a := newTestApp()
+	_ = a.Register(&mockCleanerModule{
+		mockModule: mockModule{name: "db"},
+		cleanupFn: func(ctx context.Context) error {
+			cleaned = true
+			return nil
+		},
+	})
+	_ = a.Register(&mockModule{name: "bad", initFn: func(ctx context.Context) error {
+		panic("boom")
+	}})
+	err := a.Run(context.Background())
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected PanicError, got %v", err)
+	}
+	if panicErr.Module != "bad" || panicErr.Phase != PhaseInit || panicErr.Value != "boom" {
+		t.Errorf("unexpected panic error: %+v", panicErr)
+	}
+	if !strings.Contains(string(panicErr.Stack), "panic_test.go") {
+		t.Errorf("expected stack to point at the panic site, got %s", panicErr.Stack)
+	}
+	if !strings.Contains(err.Error(), `init module "bad": panic: boom`) {
+		t.Errorf("unexpected error message: %v", err)
+	}
+	if !cleaned {
+		t.Error("expected initialized modules to be rolled back")
+	}
+}
+
+func TestApplication_Run_PanicInStopContinuesShutdown(t *testing.T) {
+	t.Parallel()
+	stopped := false
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "first", stopFn: func(ctx context.Context) error {
+		stopped = true
+		return nil
+	}})
+	_ = a.Register(&mockModule{name: "bad", stopFn: func(ctx context.Context) error {
+		panic(errTest)
+	}})
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	err := a.Run(ctx)
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || panicErr.Phase != PhaseStop || !errors.Is(err, errTest) {
+		t.Fatalf("expected stop PanicError wrapping errTest, got %v", err)
+	}
+	if !stopped {
+		t.Error("expected remaining modules to be stopped after a panic")
+	}
+}
+
+func TestApplication_Run_PanicInHook(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithHook(Hook{
+		BeforeStart: func(ctx context.Context) error { panic("hook boom") },
+	}))
+	err := a.Run(context.Background())
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || panicErr.Phase != PhaseHook || panicErr.Module != "" {
+		t.Fatalf("expected hook PanicError, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "before start hook: panic: hook boom") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+func TestApplication_Run_PanicInInterceptor(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithInterceptor(func(ctx context.Context, phase Phase, m Module, next func(ctx context.Context) error) error {
+		if phase == PhaseStart {
+			panic("interceptor boom")
+		}
+		return next(ctx)
+	}))
+	_ = a.Register(&mockModule{name: "m"})
+	var panicErr *PanicError
+	if err := a.Run(context.Background()); !errors.As(err, &panicErr) || panicErr.Phase != PhaseStart {
+		t.Errorf("expected start PanicError, got %v", err)
+	}
+}
+
+func TestApplication_Health_Panic(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockHealthModule{mockModule: mockModule{name: "m"}, healthFn: func(ctx context.Context) error {
+		panic("health boom")
+	}})
+	err := a.Health(context.Background())
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || panicErr.Phase != PhaseHealth || panicErr.Module != "m" {
+		t.Errorf("expected health PanicError, got %v", err)
+	}
+}
+
+func TestRunHooksHealthChange_Panic(t *testing.T) {
+	t.Parallel()
+	l := &mockLogger{}
+	a := newTestApp(WithLogger(l), WithHook(Hook{
+		OnHealthChange: func(ctx context.Context, transition HealthTransition) { panic("boom") },
+	}))
+	a.runHooksHealthChange(context.Background(), HealthTransition{Module: "m"})
+	if !slices.Contains(l.errs, "health change hook failed") {
+		t.Errorf("expected panic to be logged, got %v", l.errs)
+	}
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 )
@@ -9,6 +10,9 @@ import (
 type registry struct {
 	modules []Module
 	names   map[string]struct{}
+	groups  map[string]int
+	group   int
+	levels  map[string]int
 	mu      sync.RWMutex
 	locked  atomic.Bool
 }
@@ -17,6 +21,8 @@ func newRegistry() *registry {
 	return &registry{
 		modules: make([]Module, 0),
 		names:   make(map[string]struct{}),
+		groups:  make(map[string]int),
+		levels:  make(map[string]int),
 	}
 }
 
@@ -38,10 +44,22 @@ func (r *registry) register(module Module) error {
 	}
 
 	r.names[name] = struct{}{}
+	r.groups[name] = r.group
 	r.modules = append(r.modules, module)
 	return nil
 }
 
+func (r *registry) barrier() error {
+	if r.locked.Load() {
+		return ErrRegistrationClosed
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.group++
+	return nil
+}
+
 func (r *registry) lock() {
 	r.locked.Store(true)
 }
@@ -53,3 +71,128 @@ func (r *registry) getAll() []Module {
 	copy(result, r.modules)
 	return result
 }
+
+func (r *registry) levelOf(name string) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.levels[name]
+}
+
+func (r *registry) resolve() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deps, err := r.dependencies()
+	if err != nil {
+		return err
+	}
+
+	ordered := make([]Module, 0, len(r.modules))
+	placed := make(map[string]bool, len(r.modules))
+
+	for len(ordered) < len(r.modules) {
+		progressed := false
+		for _, m := range r.modules {
+			name := m.Name()
+			if placed[name] || !allPlaced(deps[name], placed) {
+				continue
+			}
+			ordered = append(ordered, m)
+			placed[name] = true
+			progressed = true
+			break
+		}
+		if !progressed {
+			return fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(findCycle(r.modules, deps, placed), " -> "))
+		}
+	}
+
+	for _, m := range ordered {
+		level := 0
+		for _, dep := range deps[m.Name()] {
+			level = max(level, r.levels[dep]+1)
+		}
+		r.levels[m.Name()] = level
+	}
+
+	r.modules = ordered
+	return nil
+}
+
+func (r *registry) dependencies() (map[string][]string, error) {
+	deps := make(map[string][]string, len(r.modules))
+	for _, m := range r.modules {
+		for _, prev := range r.modules {
+			if r.groups[prev.Name()] < r.groups[m.Name()] {
+				deps[m.Name()] = append(deps[m.Name()], prev.Name())
+			}
+		}
+
+		d, ok := m.(Dependent)
+		if !ok {
+			continue
+		}
+		for _, dep := range d.DependsOn() {
+			if _, exists := r.names[dep]; !exists {
+				return nil, fmt.Errorf("%w: module %q depends on %q", ErrDependencyNotFound, m.Name(), dep)
+			}
+			deps[m.Name()] = append(deps[m.Name()], dep)
+		}
+	}
+	return deps, nil
+}
+
+func allPlaced(deps []string, placed map[string]bool) bool {
+	for _, dep := range deps {
+		if !placed[dep] {
+			return false
+		}
+	}
+	return true
+}
+
+func findCycle(modules []Module, deps map[string][]string, placed map[string]bool) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	marks := make(map[string]int, len(modules))
+	var stack []string
+	var cycle []string
+
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		marks[name] = visiting
+		stack = append(stack, name)
+		for _, dep := range deps[name] {
+			if placed[dep] {
+				continue
+			}
+			switch marks[dep] {
+			case visiting:
+				for i, n := range stack {
+					if n == dep {
+						cycle = append(append(cycle, stack[i:]...), dep)
+						return true
+					}
+				}
+			case unvisited:
+				if visit(dep) {
+					return true
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		marks[name] = visited
+		return false
+	}
+
+	for _, m := range modules {
+		if name := m.Name(); !placed[name] && marks[name] == unvisited && visit(name) {
+			break
+		}
+	}
+	return cycle
+}
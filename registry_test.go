@@ -89,3 +89,137 @@ func TestRegistry_Lock(t *testing.T) {
 		t.Fatal("expected locked after lock()")
 	}
 }
+
+func moduleNames(modules []Module) []string {
+	names := make([]string, len(modules))
+	for i, m := range modules {
+		names[i] = m.Name()
+	}
+	return names
+}
+
+func TestRegistry_Resolve_KeepsRegistrationOrderWithoutDeps(t *testing.T) {
+	t.Parallel()
+	r := newRegistry()
+	_ = r.register(&mockModule{name: "a"})
+	_ = r.register(&mockModule{name: "b"})
+	_ = r.register(&mockModule{name: "c"})
+	if err := r.resolve(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(moduleNames(r.getAll()), ","); got != "a,b,c" {
+		t.Errorf("expected a,b,c, got %s", got)
+	}
+}
+
+func TestRegistry_Resolve_TopologicalOrder(t *testing.T) {
+	t.Parallel()
+	r := newRegistry()
+	_ = r.register(&mockModule{name: "http", deps: []string{"service"}})
+	_ = r.register(&mockModule{name: "service", deps: []string{"db", "cache"}})
+	_ = r.register(&mockModule{name: "cache"})
+	_ = r.register(&mockModule{name: "db"})
+	if err := r.resolve(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(moduleNames(r.getAll()), ","); got != "cache,db,service,http" {
+		t.Errorf("expected cache,db,service,http, got %s", got)
+	}
+}
+
+func TestRegistry_Resolve_MissingDependency(t *testing.T) {
+	t.Parallel()
+	r := newRegistry()
+	_ = r.register(&mockModule{name: "api", deps: []string{"db"}})
+	err := r.resolve()
+	if !errors.Is(err, ErrDependencyNotFound) {
+		t.Fatalf("expected ErrDependencyNotFound, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `"api"`) || !strings.Contains(err.Error(), `"db"`) {
+		t.Errorf("expected module and dependency names in error, got %v", err)
+	}
+}
+
+func TestRegistry_Resolve_Cycle(t *testing.T) {
+	t.Parallel()
+	r := newRegistry()
+	_ = r.register(&mockModule{name: "root"})
+	_ = r.register(&mockModule{name: "a", deps: []string{"b"}})
+	_ = r.register(&mockModule{name: "b", deps: []string{"c"}})
+	_ = r.register(&mockModule{name: "c", deps: []string{"a", "root"}})
+	err := r.resolve()
+	if !errors.Is(err, ErrDependencyCycle) {
+		t.Fatalf("expected ErrDependencyCycle, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "a -> b -> c -> a") {
+		t.Errorf("expected cycle path in error, got %v", err)
+	}
+}
+
+func TestRegistry_Resolve_SelfDependency(t *testing.T) {
+	t.Parallel()
+	r := newRegistry()
+	_ = r.register(&mockModule{name: "a", deps: []string{"a"}})
+	err := r.resolve()
+	if !errors.Is(err, ErrDependencyCycle) {
+		t.Fatalf("expected ErrDependencyCycle, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "a -> a") {
+		t.Errorf("expected cycle path in error, got %v", err)
+	}
+}
+
+func TestRegistry_Resolve_Levels(t *testing.T) {
+	t.Parallel()
+	r := newRegistry()
+	_ = r.register(&mockModule{name: "db"})
+	_ = r.register(&mockModule{name: "cache"})
+	_ = r.register(&mockModule{name: "service", deps: []string{"db"}})
+	_ = r.register(&mockModule{name: "http", deps: []string{"service", "cache"}})
+	if err := r.resolve(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]int{"db": 0, "cache": 0, "service": 1, "http": 2}
+	for name, level := range expected {
+		if got := r.levelOf(name); got != level {
+			t.Errorf("expected level %d for %s, got %d", level, name, got)
+		}
+	}
+}
+
+func TestRegistry_Barrier(t *testing.T) {
+	t.Parallel()
+	r := newRegistry()
+	_ = r.register(&mockModule{name: "db"})
+	_ = r.register(&mockModule{name: "cache"})
+	if err := r.barrier(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = r.register(&mockModule{name: "http"})
+	if err := r.resolve(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.levelOf("cache") != 0 || r.levelOf("http") != 1 {
+		t.Errorf("expected barrier to raise level, got cache=%d http=%d", r.levelOf("cache"), r.levelOf("http"))
+	}
+}
+
+func TestRegistry_Barrier_ConflictingDependency(t *testing.T) {
+	t.Parallel()
+	r := newRegistry()
+	_ = r.register(&mockModule{name: "early", deps: []string{"late"}})
+	_ = r.barrier()
+	_ = r.register(&mockModule{name: "late"})
+	if err := r.resolve(); !errors.Is(err, ErrDependencyCycle) {
+		t.Errorf("expected ErrDependencyCycle, got %v", err)
+	}
+}
+
+func TestRegistry_Barrier_Locked(t *testing.T) {
+	t.Parallel()
+	r := newRegistry()
+	r.lock()
+	if err := r.barrier(); !errors.Is(err, ErrRegistrationClosed) {
+		t.Errorf("expected ErrRegistrationClosed, got %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+)
+
+func (a *Application) Reload(ctx context.Context) error {
+	a.reloadMu.Lock()
+	defer a.reloadMu.Unlock()
+
+	if a.State() != StateRunning {
+		return ErrApplicationNotRunning
+	}
+
+	a.logger.Info("reloading modules")
+	if err := a.runHooksBeforeReload(ctx); err != nil {
+		a.logger.Error("before reload hook failed, skipping reload", "error", err)
+		return hookError("before reload", err)
+	}
+
+	reloadErr := a.runner.reloadAll(ctx)
+	if reloadErr != nil {
+		a.logger.Error("reload completed with errors", "error", reloadErr)
+	} else {
+		a.logger.Info("reload completed successfully")
+	}
+
+	if err := a.runHooksAfterReload(ctx); err != nil {
+		a.logger.Error("after reload hook failed", "error", err)
+		reloadErr = errors.Join(reloadErr, hookError("after reload", err))
+	}
+	return reloadErr
+}
+
+func (a *Application) reloadOnSignal(ctx context.Context, _ os.Signal) {
+	if err := a.Reload(ctx); err != nil {
+		a.logger.Error("reload failed", "error", err)
+	}
+}
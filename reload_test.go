@@ -0,0 +1,208 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+)
+
+func TestApplication_Reload_NotRunning(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	if err := a.Reload(context.Background()); !errors.Is(err, ErrApplicationNotRunning) {
+		t.Errorf("expected ErrApplicationNotRunning, got %v", err)
+	}
+}
+
+func TestApplication_Reload_OrderAndErrors(t *testing.T) {
+	t.Parallel()
+	var order []string
+	reload := func(name string, err error) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			order = append(order, name)
+			return err
+		}
+	}
+	a := newTestApp()
+	_ = a.Register(&mockReloaderModule{mockModule: mockModule{name: "api", deps: []string{"config"}}, reloadFn: reload("api", nil)})
+	_ = a.Register(&mockReloaderModule{mockModule: mockModule{name: "config"}, reloadFn: reload("config", errTest)})
+	_ = a.Register(&mockModule{name: "plain"})
+	cancel, errCh := runInBackground(a)
+	waitFor(t, isRunning(a))
+
+	err := a.Reload(context.Background())
+	if !errors.Is(err, errTest) || !strings.Contains(err.Error(), `reload module "config"`) {
+		t.Errorf("expected wrapped reload error, got %v", err)
+	}
+	if strings.Join(order, ",") != "config,api" {
+		t.Errorf("expected dependency order [config api], got %v", order)
+	}
+	if a.State() != StateRunning {
+		t.Errorf("expected application to keep running after reload failure, got %s", a.State())
+	}
+
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Errorf("unexpected run error: %v", err)
+	}
+}
+
+func TestApplication_Reload_Hooks(t *testing.T) {
+	t.Parallel()
+	var order []string
+	a := newTestApp(WithHook(Hook{
+		BeforeReload: func(ctx context.Context) error {
+			order = append(order, "before")
+			return nil
+		},
+		AfterReload: func(ctx context.Context) error {
+			order = append(order, "after")
+			return errTest
+		},
+	}))
+	_ = a.Register(&mockReloaderModule{mockModule: mockModule{name: "m"}, reloadFn: func(ctx context.Context) error {
+		order = append(order, "m")
+		return nil
+	}})
+	a.states.app = StateRunning
+	a.runner.setProgress(a.registry.getAll()[0], progressStarted)
+
+	err := a.Reload(context.Background())
+	if !errors.Is(err, errTest) || !strings.Contains(err.Error(), "after reload hook") {
+		t.Errorf("expected after reload hook error, got %v", err)
+	}
+	if strings.Join(order, ",") != "before,m,after" {
+		t.Errorf("unexpected order: %v", order)
+	}
+}
+
+func TestApplication_Reload_BeforeHookErrorSkipsModules(t *testing.T) {
+	t.Parallel()
+	reloaded := false
+	a := newTestApp(WithHook(Hook{
+		BeforeReload: func(ctx context.Context) error { return errTest },
+	}))
+	_ = a.Register(&mockReloaderModule{mockModule: mockModule{name: "m"}, reloadFn: func(ctx context.Context) error {
+		reloaded = true
+		return nil
+	}})
+	a.states.app = StateRunning
+	a.runner.setProgress(a.registry.getAll()[0], progressStarted)
+
+	if err := a.Reload(context.Background()); !errors.Is(err, errTest) {
+		t.Errorf("expected errTest, got %v", err)
+	}
+	if reloaded {
+		t.Error("expected modules not to be reloaded after before reload hook failure")
+	}
+}
+
+func TestApplication_Reload_SkipsModulesNotStarted(t *testing.T) {
+	t.Parallel()
+	reloaded := false
+	a := newTestApp()
+	_ = a.Register(&mockReloaderModule{mockModule: mockModule{name: "m"}, reloadFn: func(ctx context.Context) error {
+		reloaded = true
+		return nil
+	}})
+	a.states.app = StateRunning
+	if err := a.Reload(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if reloaded {
+		t.Error("expected module that never started not to be reloaded")
+	}
+}
+
+func TestApplication_ReloadOnSIGHUPByDefault(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	if _, ok := a.signalHandlers[syscall.SIGHUP]; !ok {
+		t.Error("expected SIGHUP to trigger reload by default")
+	}
+}
+
+func TestApplication_Run_SIGHUPReloads(t *testing.T) {
+	var reloads atomic.Int32
+	logger := &mockLogger{}
+	a := newTestApp(WithLogger(logger))
+	_ = a.Register(&mockReloaderModule{mockModule: mockModule{name: "m"}, reloadFn: func(ctx context.Context) error {
+		if reloads.Add(1) == 1 {
+			return errTest
+		}
+		return nil
+	}})
+	cancel, errCh := runInBackground(a)
+	defer func() { cancel(); <-errCh }()
+	waitFor(t, isRunning(a))
+
+	_ = syscall.Kill(os.Getpid(), syscall.SIGHUP)
+	waitFor(t, func() bool { return reloads.Load() == 1 })
+	waitFor(t, func() bool {
+		logger.mu.Lock()
+		defer logger.mu.Unlock()
+		return slices.Contains(logger.errs, "reload failed")
+	})
+	if a.State() != StateRunning {
+		t.Fatalf("expected failed reload not to shut down, got %s", a.State())
+	}
+
+	_ = syscall.Kill(os.Getpid(), syscall.SIGHUP)
+	waitFor(t, func() bool { return reloads.Load() == 2 })
+	if a.State() != StateRunning {
+		t.Errorf("expected application to keep running, got %s", a.State())
+	}
+}
+
+func TestApplication_Reload_DoesNotOverlapStop(t *testing.T) {
+	t.Parallel()
+	var reloading, overlapped atomic.Bool
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	logger := &mockLogger{}
+	a := newTestApp(WithLogger(logger))
+	_ = a.Register(&mockReloaderModule{
+		mockModule: mockModule{name: "m", stopFn: func(ctx context.Context) error {
+			overlapped.Store(reloading.Load())
+			return nil
+		}},
+		reloadFn: func(ctx context.Context) error {
+			reloading.Store(true)
+			close(entered)
+			<-release
+			reloading.Store(false)
+			return nil
+		},
+	})
+	cancel, errCh := runInBackground(a)
+	waitFor(t, isRunning(a))
+
+	reloadErr := make(chan error, 1)
+	go func() { reloadErr <- a.Reload(context.Background()) }()
+	<-entered
+	cancel()
+	waitFor(t, func() bool {
+		logger.mu.Lock()
+		defer logger.mu.Unlock()
+		return slices.Contains(logger.infos, "shutting down")
+	})
+	close(release)
+
+	if err := <-reloadErr; err != nil {
+		t.Errorf("unexpected reload error: %v", err)
+	}
+	if err := <-errCh; err != nil {
+		t.Errorf("unexpected run error: %v", err)
+	}
+	if overlapped.Load() {
+		t.Error("expected Stop not to run while Reload is in progress")
+	}
+	if err := a.Reload(context.Background()); !errors.Is(err, ErrApplicationNotRunning) {
+		t.Errorf("expected ErrApplicationNotRunning after shutdown, got %v", err)
+	}
+}
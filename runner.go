@@ -4,56 +4,496 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+type progress int
+
+const (
+	progressNone progress = iota
+	progressInitialized
+	progressStarted
+	progressAbandoned
+)
+
+const (
+	defaultForceStopTimeout = 5 * time.Second
+	defaultCancelGrace      = time.Second
 )
 
 type runner struct {
-	registry *registry
-	logger   Logger
+	registry        *registry
+	states          *stateTracker
+	logger          Logger
+	parallel        bool
+	maxConcurrency  int
+	startTimeouts   map[string]time.Duration
+	readyTimeout    time.Duration
+	stopTimeouts    map[string]time.Duration
+	shutdownTimeout time.Duration
+	cancelGrace     time.Duration
+	dumpStacks      bool
+	interceptors    []Interceptor
+	onRollback      func()
+
+	mu       sync.Mutex
+	progress map[string]progress
 }
 
 func (r *runner) initAll(ctx context.Context) error {
-	for _, module := range r.registry.getAll() {
-		r.logger.Info("initializing module", "module", module.Name())
-		if err := module.Init(ctx); err != nil {
-			return fmt.Errorf("init module %q: %w", module.Name(), err)
+	return r.initModules(ctx, r.registry.getAll())
+}
+
+func (r *runner) initModules(ctx context.Context, modules []Module) error {
+	for _, group := range r.groups(modules) {
+		errs := r.runGroup(group, func(module Module) error {
+			r.logger.Info("initializing module", "module", module.Name())
+			r.transition(module, StateInitializing, nil)
+			if err := r.awaitStartup(ctx, PhaseInit, module, module.Init); err != nil {
+				err = moduleError(module.Name(), PhaseInit, err)
+				r.transition(module, StateFailed, err)
+				return err
+			}
+			r.setProgress(module, progressInitialized)
+			r.transition(module, StateInitialized, nil)
+			return nil
+		})
+
+		if err := errors.Join(errs...); err != nil {
+			return errors.Join(err, r.rollback(modules))
 		}
 	}
 	return nil
 }
 
 func (r *runner) startAll(ctx context.Context) (startedModules []Module, err error) {
-	modules := r.registry.getAll()
+	return r.startModules(ctx, r.registry.getAll())
+}
+
+func (r *runner) startModules(ctx context.Context, modules []Module) (startedModules []Module, err error) {
 	started := make([]Module, 0, len(modules))
 
-	for _, module := range modules {
-		r.logger.Info("starting module", "module", module.Name())
-		if err := module.Start(ctx); err != nil {
-			shutdownErr := r.shutdownModules(context.Background(), started)
-			return nil, errors.Join(
-				fmt.Errorf("start module %q: %w", module.Name(), err),
-				shutdownErr,
-			)
+	for _, group := range r.groups(modules) {
+		errs := r.runGroup(group, func(module Module) error {
+			r.logger.Info("starting module", "module", module.Name())
+			r.transition(module, StateStarting, nil)
+			if err := r.awaitStartup(ctx, PhaseStart, module, module.Start); err != nil {
+				err = moduleError(module.Name(), PhaseStart, err)
+				r.transition(module, StateFailed, err)
+				return err
+			}
+			r.setProgress(module, progressStarted)
+			if err := r.awaitReady(ctx, module); err != nil {
+				err = moduleError(module.Name(), PhaseStart, err)
+				r.transition(module, StateFailed, err)
+				return err
+			}
+			r.transition(module, StateRunning, nil)
+			return nil
+		})
+
+		var failed []error
+		for i, module := range group {
+			if errs[i] != nil {
+				failed = append(failed, errs[i])
+				continue
+			}
+			started = append(started, module)
+		}
+
+		if len(failed) > 0 {
+			return nil, errors.Join(append(failed, r.rollback(modules))...)
 		}
-		started = append(started, module)
 	}
 
 	return started, nil
 }
 
+func (r *runner) awaitStartup(ctx context.Context, phase Phase, m Module, fn func(context.Context) error) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("%w: %w", ErrStartupAborted, context.Cause(ctx))
+	}
+
+	if budget, ok := StartupBudgetFromContext(ctx); ok && budget <= 0 {
+		return ErrStartupTimedOut
+	}
+	timeout, stop, timeoutErr := startupTimer(ctx, r.startTimeout(m), ErrModuleStartTimedOut)
+	defer stop()
+
+	callCtx, abandon := context.WithCancelCause(ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- r.intercept(callCtx, phase, m, fn)
+	}()
+	return r.settleStartup(ctx, phase, m, errCh, abandon, timeout, timeoutErr)
+}
+
+func (r *runner) settleStartup(
+	ctx context.Context,
+	phase Phase,
+	m Module,
+	errCh <-chan error,
+	abandon context.CancelCauseFunc,
+	timeout <-chan time.Time,
+	timeoutErr error,
+) error {
+	var err error
+	select {
+	case err = <-errCh:
+		return err
+	case <-timeout:
+		r.logger.Error("module startup timed out, cancelling", "module", m.Name())
+		err = timeoutErr
+	case <-ctx.Done():
+		r.logger.Error("module startup aborted, cancelling", "module", m.Name())
+		err = fmt.Errorf("%w: %w", ErrStartupAborted, context.Cause(ctx))
+	}
+
+	abandon(err)
+	grace := time.NewTimer(r.graceAfterCancel())
+	defer grace.Stop()
+	select {
+	case lateErr := <-errCh:
+		if lateErr == nil {
+			completed := progressInitialized
+			if phase == PhaseStart {
+				completed = progressStarted
+			}
+			r.setProgress(m, completed)
+		}
+	case <-grace.C:
+		r.logger.Error("module did not return after cancellation, rolling back without it", "module", m.Name())
+		r.setProgress(m, progressAbandoned)
+	}
+	return err
+}
+
+func (r *runner) graceAfterCancel() time.Duration {
+	grace := r.cancelGrace
+	if grace <= 0 {
+		grace = defaultCancelGrace
+	}
+	if r.shutdownTimeout > 0 {
+		grace = min(grace, r.shutdownTimeout)
+	}
+	return grace
+}
+
+func (r *runner) awaitReady(ctx context.Context, m Module) error {
+	notifier, ok := m.(ReadyNotifier)
+	if !ok {
+		return nil
+	}
+
+	r.logger.Info("waiting for module to become ready", "module", m.Name())
+	timeout, stop, timeoutErr := startupTimer(ctx, r.readyTimeout, ErrModuleNotReady)
+	defer stop()
+
+	select {
+	case <-notifier.Ready():
+		return nil
+	case <-timeout:
+		r.logger.Error("module did not become ready in time", "module", m.Name())
+		return timeoutErr
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %w", ErrStartupAborted, context.Cause(ctx))
+	}
+}
+
+func startupTimer(ctx context.Context, limit time.Duration, limitErr error) (<-chan time.Time, func(), error) {
+	if budget, ok := StartupBudgetFromContext(ctx); ok && (limit <= 0 || budget <= limit) {
+		limit, limitErr = budget, ErrStartupTimedOut
+	} else if limit <= 0 {
+		return nil, func() {}, nil
+	}
+	timer := time.NewTimer(limit)
+	return timer.C, func() { timer.Stop() }, limitErr
+}
+
+func (r *runner) startTimeout(m Module) time.Duration {
+	if timeout, ok := r.startTimeouts[m.Name()]; ok {
+		return timeout
+	}
+	if t, ok := m.(StartTimeouter); ok {
+		return t.StartTimeout()
+	}
+	return 0
+}
+
 func (r *runner) shutdownModules(ctx context.Context, modules []Module) error {
 	var errs []error
-	for i := len(modules) - 1; i >= 0; i-- {
-		m := modules[i]
-		r.logger.Info("stopping module", "module", m.Name())
-		if err := m.Stop(ctx); err != nil {
-			wrappedErr := fmt.Errorf("stop module %q: %w", m.Name(), err)
-			r.logger.Error("failed to stop module", "module", m.Name(), "error", err)
-			errs = append(errs, wrappedErr)
-		}
+	report := &shutdownReport{}
+	groups := r.groups(modules)
+	for i := len(groups) - 1; i >= 0; i-- {
+		errs = append(errs, r.runGroup(groups[i], func(m Module) error {
+			switch r.progressOf(m) {
+			case progressStarted:
+				return r.stopModule(ctx, m, report)
+			case progressInitialized:
+				return r.cleanupModule(ctx, m)
+			case progressAbandoned:
+				r.setProgress(m, progressNone)
+				r.logger.Error("module startup still in progress, force stopping", "module", m.Name())
+				return r.forceStop(ctx, m)
+			default:
+				return nil
+			}
+		})...)
+	}
+	if err := report.err(); err != nil {
+		errs = append(errs, err)
 	}
 	return errors.Join(errs...)
 }
 
+func (r *runner) stopModule(ctx context.Context, m Module, report *shutdownReport) error {
+	if ctx.Err() != nil {
+		r.setProgress(m, progressNone)
+		r.logger.Error("skipping module stop, shutdown budget exhausted", "module", m.Name())
+		report.timedOut(r.dumpStacks)
+		report.add(&report.pending, m.Name())
+		if state, _ := r.states.moduleState(m.Name()); state != StateFailed {
+			r.transition(m, StateFailed, moduleError(m.Name(), PhaseStop, ErrGracefulShutdownTimedOut))
+		}
+		return r.forceStop(ctx, m)
+	}
+
+	r.logger.Info("stopping module", "module", m.Name())
+	r.transition(m, StateStopping, nil)
+
+	timedOut, err := r.awaitStop(ctx, m)
+	r.setProgress(m, progressNone)
+	if timedOut && ctx.Err() != nil {
+		report.timedOut(r.dumpStacks)
+		report.add(&report.inProgress, m.Name())
+		err = fmt.Errorf("%w: %w", ErrModuleStopTimedOut, ErrGracefulShutdownTimedOut)
+		r.transition(m, StateFailed, moduleError(m.Name(), PhaseStop, err))
+		return r.forceStop(ctx, m)
+	}
+	if timedOut {
+		err = errors.Join(err, r.forceStop(ctx, m))
+	} else {
+		report.add(&report.completed, m.Name())
+	}
+	if err != nil {
+		r.logger.Error("failed to stop module", "module", m.Name(), "error", err)
+		err = moduleError(m.Name(), PhaseStop, err)
+		r.transition(m, StateFailed, err)
+		return err
+	}
+	r.transition(m, StateStopped, nil)
+	return nil
+}
+
+func (r *runner) awaitStop(ctx context.Context, m Module) (timedOut bool, err error) {
+	stopCtx, cancel := ctx, context.CancelFunc(func() {})
+	if timeout := r.stopTimeout(m); timeout > 0 {
+		stopCtx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- r.intercept(stopCtx, PhaseStop, m, m.Stop)
+	}()
+
+	select {
+	case err = <-errCh:
+		return false, err
+	case <-stopCtx.Done():
+		select {
+		case err = <-errCh:
+			return false, err
+		default:
+			r.logger.Error("module stop timed out, moving on", "module", m.Name())
+			return true, ErrModuleStopTimedOut
+		}
+	}
+}
+
+func (r *runner) forceStop(ctx context.Context, m Module) error {
+	stopper, ok := m.(ForceStopper)
+	if !ok {
+		return nil
+	}
+	timeout := r.stopTimeout(m)
+	if timeout <= 0 {
+		timeout = defaultForceStopTimeout
+	}
+	forceCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	r.logger.Info("force stopping module", "module", m.Name())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- r.intercept(forceCtx, PhaseForceStop, m, stopper.ForceStop)
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-forceCtx.Done():
+		select {
+		case err = <-errCh:
+		default:
+			err = ErrModuleStopTimedOut
+		}
+	}
+	if err != nil {
+		r.logger.Error("failed to force stop module", "module", m.Name(), "error", err)
+		return moduleError(m.Name(), PhaseForceStop, err)
+	}
+	return nil
+}
+
+func (r *runner) stopTimeout(m Module) time.Duration {
+	if timeout, ok := r.stopTimeouts[m.Name()]; ok {
+		return timeout
+	}
+	if t, ok := m.(StopTimeouter); ok {
+		return t.StopTimeout()
+	}
+	return 0
+}
+
+func (r *runner) cleanupModule(ctx context.Context, m Module) error {
+	r.setProgress(m, progressNone)
+	r.transition(m, StateStopping, nil)
+	if cleaner, ok := m.(Cleaner); ok {
+		r.logger.Info("cleaning up module", "module", m.Name())
+		if err := r.intercept(ctx, PhaseCleanup, m, cleaner.Cleanup); err != nil {
+			r.logger.Error("failed to clean up module", "module", m.Name(), "error", err)
+			err = moduleError(m.Name(), PhaseCleanup, err)
+			r.transition(m, StateFailed, err)
+			return err
+		}
+	}
+	r.transition(m, StateStopped, nil)
+	return nil
+}
+
 func (r *runner) shutdownAll(ctx context.Context) error {
 	return r.shutdownModules(ctx, r.registry.getAll())
 }
+
+func (r *runner) shutdownContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if r.shutdownTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.shutdownTimeout)
+	}
+	return withShutdownBudget(ctx), cancel
+}
+
+func (r *runner) rollback(modules []Module) error {
+	if r.onRollback != nil {
+		r.onRollback()
+	}
+	ctx, cancel := r.shutdownContext()
+	defer cancel()
+	return r.shutdownModules(ctx, modules)
+}
+
+func (r *runner) reloadAll(ctx context.Context) error {
+	var errs []error
+	for _, m := range r.registry.getAll() {
+		reloader, ok := m.(Reloader)
+		if !ok || r.progressOf(m) != progressStarted {
+			continue
+		}
+		r.logger.Info("reloading module", "module", m.Name())
+		if err := r.intercept(ctx, PhaseReload, m, reloader.Reload); err != nil {
+			r.logger.Error("failed to reload module", "module", m.Name(), "error", err)
+			errs = append(errs, moduleError(m.Name(), PhaseReload, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *runner) restartModules(ctx, stopCtx context.Context, modules []Module) error {
+	if err := r.shutdownModules(stopCtx, modules); err != nil {
+		r.logger.Error("restart continues after stop errors", "error", err)
+	}
+	if err := r.initModules(ctx, modules); err != nil {
+		return err
+	}
+	_, err := r.startModules(ctx, modules)
+	return err
+}
+
+func (r *runner) progressOf(module Module) progress {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.progress[module.Name()]
+}
+
+func (r *runner) setProgress(module Module, p progress) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.progress == nil {
+		r.progress = make(map[string]progress)
+	}
+	r.progress[module.Name()] = p
+}
+
+func (r *runner) transition(module Module, to State, err error) {
+	if tErr := r.states.transitionModule(module.Name(), to, err); tErr != nil {
+		r.logger.Error("invalid module state transition", "module", module.Name(), "error", tErr)
+	}
+}
+
+func (r *runner) groups(modules []Module) [][]Module {
+	if !r.parallel {
+		groups := make([][]Module, len(modules))
+		for i, m := range modules {
+			groups[i] = []Module{m}
+		}
+		return groups
+	}
+
+	byLevel := make(map[int][]Module)
+	for _, m := range modules {
+		level := r.registry.levelOf(m.Name())
+		byLevel[level] = append(byLevel[level], m)
+	}
+
+	levels := make([]int, 0, len(byLevel))
+	for level := range byLevel {
+		levels = append(levels, level)
+	}
+	sort.Ints(levels)
+
+	groups := make([][]Module, len(levels))
+	for i, level := range levels {
+		groups[i] = byLevel[level]
+	}
+	return groups
+}
+
+func (r *runner) runGroup(group []Module, fn func(Module) error) []error {
+	errs := make([]error, len(group))
+	if len(group) == 1 {
+		errs[0] = fn(group[0])
+		return errs
+	}
+
+	limit := r.maxConcurrency
+	if limit <= 0 || limit > len(group) {
+		limit = len(group)
+	}
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, m := range group {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(m)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func newTestRunner(modules ...Module) *runner {
@@ -12,7 +15,17 @@ func newTestRunner(modules ...Module) *runner {
 	for _, m := range modules {
 		_ = reg.register(m)
 	}
-	return &runner{registry: reg, logger: &noopLogger{}}
+	return &runner{registry: reg, states: newStateTracker(), logger: &noopLogger{}, cancelGrace: 20 * time.Millisecond}
+}
+
+func startTestRunner(t *testing.T, r *runner) {
+	t.Helper()
+	if err := r.initAll(context.Background()); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	if _, err := r.startAll(context.Background()); err != nil {
+		t.Fatalf("unexpected start error: %v", err)
+	}
 }
 
 func TestRunner_InitAll_Success(t *testing.T) {
@@ -82,7 +95,8 @@ func TestRunner_ShutdownModules_Success(t *testing.T) {
 		order = append(order, "m2")
 		return nil
 	}}
-	r := newTestRunner()
+	r := newTestRunner(m1, m2)
+	startTestRunner(t, r)
 	err := r.shutdownModules(context.Background(), []Module{m1, m2})
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
@@ -97,7 +111,8 @@ func TestRunner_ShutdownModules_WithErrors(t *testing.T) {
 	m := &mockModule{name: "fail", stopFn: func(ctx context.Context) error {
 		return errTest
 	}}
-	r := newTestRunner()
+	r := newTestRunner(m)
+	startTestRunner(t, r)
 	err := r.shutdownModules(context.Background(), []Module{m})
 	if err == nil {
 		t.Fatal("expected error")
@@ -124,6 +139,7 @@ func TestRunner_ShutdownAll(t *testing.T) {
 		return nil
 	}}
 	r := newTestRunner(m)
+	startTestRunner(t, r)
 	err := r.shutdownAll(context.Background())
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
@@ -132,3 +148,824 @@ func TestRunner_ShutdownAll(t *testing.T) {
 		t.Errorf("expected module to be stopped")
 	}
 }
+
+func newParallelTestRunner(maxConcurrency int, modules ...Module) *runner {
+	r := newTestRunner(modules...)
+	_ = r.registry.resolve()
+	r.parallel = true
+	r.maxConcurrency = maxConcurrency
+	return r
+}
+
+func TestRunner_Parallel_StartsLevelConcurrently(t *testing.T) {
+	t.Parallel()
+	var inFlight, peak atomic.Int32
+	slowStart := func(ctx context.Context) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	}
+	r := newParallelTestRunner(0,
+		&mockModule{name: "a", startFn: slowStart},
+		&mockModule{name: "b", startFn: slowStart},
+		&mockModule{name: "c", startFn: slowStart},
+	)
+	started, err := r.startAll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(started) != 3 {
+		t.Errorf("expected 3 started modules, got %d", len(started))
+	}
+	if peak.Load() != 3 {
+		t.Errorf("expected 3 concurrent starts, got %d", peak.Load())
+	}
+}
+
+func TestRunner_Parallel_MaxConcurrency(t *testing.T) {
+	t.Parallel()
+	var inFlight, peak atomic.Int32
+	slowInit := func(ctx context.Context) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}
+	var modules []Module
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		modules = append(modules, &mockModule{name: name, initFn: slowInit})
+	}
+	r := newParallelTestRunner(2, modules...)
+	if err := r.initAll(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 concurrent inits, got %d", peak.Load())
+	}
+}
+
+func TestRunner_Parallel_RespectsLevels(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, name)
+			return nil
+		}
+	}
+	r := newParallelTestRunner(0,
+		&mockModule{name: "api", deps: []string{"db", "cache"}, startFn: record("api"), stopFn: record("api")},
+		&mockModule{name: "db", startFn: record("db"), stopFn: record("db")},
+		&mockModule{name: "cache", startFn: record("cache"), stopFn: record("cache")},
+	)
+	started, err := r.startAll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order[2] != "api" {
+		t.Errorf("expected api to start last, got %v", order)
+	}
+	order = nil
+	if err := r.shutdownModules(context.Background(), started); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order[0] != "api" {
+		t.Errorf("expected api to stop first, got %v", order)
+	}
+}
+
+func TestRunner_Parallel_StartErrorRollsBackLevel(t *testing.T) {
+	t.Parallel()
+	var stopped atomic.Int32
+	stop := func(ctx context.Context) error {
+		stopped.Add(1)
+		return nil
+	}
+	errOther := errors.New("other error")
+	r := newParallelTestRunner(0,
+		&mockModule{name: "ok", stopFn: stop},
+		&mockModule{name: "bad1", startFn: func(ctx context.Context) error { return errTest }, stopFn: stop},
+		&mockModule{name: "bad2", startFn: func(ctx context.Context) error { return errOther }, stopFn: stop},
+		&mockModule{name: "later", deps: []string{"ok"}, stopFn: stop},
+	)
+	started, err := r.startAll(context.Background())
+	if started != nil {
+		t.Errorf("expected nil started, got %v", started)
+	}
+	if !errors.Is(err, errTest) || !errors.Is(err, errOther) {
+		t.Errorf("expected both start errors, got %v", err)
+	}
+	if stopped.Load() != 1 {
+		t.Errorf("expected only the started module to be stopped, got %d", stopped.Load())
+	}
+}
+
+func TestRunner_Parallel_ShutdownJoinsErrors(t *testing.T) {
+	t.Parallel()
+	errOther := errors.New("other error")
+	m1 := &mockModule{name: "m1", stopFn: func(ctx context.Context) error { return errTest }}
+	m2 := &mockModule{name: "m2", stopFn: func(ctx context.Context) error { return errOther }}
+	r := newParallelTestRunner(0, m1, m2)
+	startTestRunner(t, r)
+	err := r.shutdownAll(context.Background())
+	if !errors.Is(err, errTest) || !errors.Is(err, errOther) {
+		t.Errorf("expected both stop errors, got %v", err)
+	}
+}
+
+func TestRunner_InitAll_ErrorCleansUpInitialized(t *testing.T) {
+	t.Parallel()
+	var order []string
+	cleanup := func(name string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			order = append(order, name)
+			return nil
+		}
+	}
+	stopCalled := false
+	m1 := &mockCleanerModule{mockModule: mockModule{name: "m1"}, cleanupFn: cleanup("m1")}
+	m2 := &mockModule{name: "m2", stopFn: func(ctx context.Context) error {
+		stopCalled = true
+		return nil
+	}}
+	m3 := &mockCleanerModule{mockModule: mockModule{name: "m3"}, cleanupFn: cleanup("m3")}
+	bad := &mockCleanerModule{
+		mockModule: mockModule{name: "bad", initFn: func(ctx context.Context) error { return errTest }},
+		cleanupFn:  cleanup("bad"),
+	}
+	never := &mockCleanerModule{mockModule: mockModule{name: "never"}, cleanupFn: cleanup("never")}
+	r := newTestRunner(m1, m2, m3, bad, never)
+	err := r.initAll(context.Background())
+	if !errors.Is(err, errTest) {
+		t.Fatalf("expected errTest, got %v", err)
+	}
+	if strings.Join(order, ",") != "m3,m1" {
+		t.Errorf("expected cleanup in reverse order [m3 m1], got %v", order)
+	}
+	if stopCalled {
+		t.Error("expected Stop not to be called for modules that never started")
+	}
+	if s, _ := r.states.moduleState("m1"); s != StateStopped {
+		t.Errorf("expected cleaned up module to be stopped, got %s", s)
+	}
+	if s, _ := r.states.moduleState("m2"); s != StateStopped {
+		t.Errorf("expected rolled back module without Cleaner to be stopped, got %s", s)
+	}
+}
+
+func TestRunner_InitAll_CleanupErrorsJoined(t *testing.T) {
+	t.Parallel()
+	errCleanup := errors.New("cleanup error")
+	m1 := &mockCleanerModule{
+		mockModule: mockModule{name: "m1"},
+		cleanupFn:  func(ctx context.Context) error { return errCleanup },
+	}
+	bad := &mockModule{name: "bad", initFn: func(ctx context.Context) error { return errTest }}
+	r := newTestRunner(m1, bad)
+	err := r.initAll(context.Background())
+	if !errors.Is(err, errTest) || !errors.Is(err, errCleanup) {
+		t.Errorf("expected init and cleanup errors, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `cleanup module "m1"`) {
+		t.Errorf("expected cleanup module name in error, got %v", err)
+	}
+}
+
+func TestRunner_Parallel_InitErrorCleansUpLevel(t *testing.T) {
+	t.Parallel()
+	var cleaned atomic.Int32
+	cleanup := func(ctx context.Context) error {
+		cleaned.Add(1)
+		return nil
+	}
+	r := newParallelTestRunner(0,
+		&mockCleanerModule{mockModule: mockModule{name: "ok"}, cleanupFn: cleanup},
+		&mockCleanerModule{mockModule: mockModule{name: "bad", initFn: func(ctx context.Context) error { return errTest }}, cleanupFn: cleanup},
+	)
+	if err := r.initAll(context.Background()); !errors.Is(err, errTest) {
+		t.Fatalf("expected errTest, got %v", err)
+	}
+	if cleaned.Load() != 1 {
+		t.Errorf("expected only the initialized sibling to be cleaned up, got %d", cleaned.Load())
+	}
+}
+
+func TestRunner_ShutdownAll_SkipsModulesNotStarted(t *testing.T) {
+	t.Parallel()
+	var stopped, cleaned []string
+	newModule := func(name string) *mockCleanerModule {
+		return &mockCleanerModule{
+			mockModule: mockModule{name: name, stopFn: func(ctx context.Context) error {
+				stopped = append(stopped, name)
+				return nil
+			}},
+			cleanupFn: func(ctx context.Context) error {
+				cleaned = append(cleaned, name)
+				return nil
+			},
+		}
+	}
+	started := newModule("started")
+	initialized := newModule("initialized")
+	created := newModule("created")
+	r := newTestRunner(started, initialized, created)
+	if err := r.initModules(context.Background(), []Module{started, initialized}); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	if _, err := r.startModules(context.Background(), []Module{started}); err != nil {
+		t.Fatalf("unexpected start error: %v", err)
+	}
+	if err := r.shutdownAll(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(stopped, ",") != "started" {
+		t.Errorf("expected only started module to be stopped, got %v", stopped)
+	}
+	if strings.Join(cleaned, ",") != "initialized" {
+		t.Errorf("expected only initialized module to be cleaned up, got %v", cleaned)
+	}
+	if err := r.shutdownAll(context.Background()); err != nil || len(stopped) != 1 || len(cleaned) != 1 {
+		t.Errorf("expected second shutdown to be a no-op, got %v %v %v", err, stopped, cleaned)
+	}
+}
+
+func TestRunner_StartAll_ErrorCleansUpNotStarted(t *testing.T) {
+	t.Parallel()
+	var cleaned []string
+	cleanup := func(name string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			cleaned = append(cleaned, name)
+			return nil
+		}
+	}
+	r := newTestRunner(
+		&mockCleanerModule{mockModule: mockModule{name: "ok"}, cleanupFn: cleanup("ok")},
+		&mockCleanerModule{
+			mockModule: mockModule{name: "bad", startFn: func(ctx context.Context) error { return errTest }},
+			cleanupFn:  cleanup("bad"),
+		},
+		&mockCleanerModule{mockModule: mockModule{name: "later"}, cleanupFn: cleanup("later")},
+	)
+	if err := r.initAll(context.Background()); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	if _, err := r.startAll(context.Background()); !errors.Is(err, errTest) {
+		t.Fatalf("expected errTest, got %v", err)
+	}
+	if strings.Join(cleaned, ",") != "later,bad" {
+		t.Errorf("expected modules that never started to be cleaned up, got %v", cleaned)
+	}
+}
+
+func TestRunner_ShutdownModules_PerModuleTimeout(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	fastStopped := false
+	slow := &mockStopTimeoutModule{
+		mockModule: mockModule{name: "slow", stopFn: func(ctx context.Context) error {
+			<-release
+			return nil
+		}},
+		timeout: 20 * time.Millisecond,
+	}
+	fast := &mockModule{name: "fast", stopFn: func(ctx context.Context) error {
+		fastStopped = true
+		return nil
+	}}
+	r := newTestRunner(fast, slow)
+	startTestRunner(t, r)
+	err := r.shutdownAll(context.Background())
+	if !errors.Is(err, ErrModuleStopTimedOut) {
+		t.Fatalf("expected ErrModuleStopTimedOut, got %v", err)
+	}
+	if errors.Is(err, ErrGracefulShutdownTimedOut) {
+		t.Errorf("expected only the module slice to be exceeded, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `stop module "slow"`) {
+		t.Errorf("expected slow module in error, got %v", err)
+	}
+	if !fastStopped {
+		t.Error("expected shutdown to move on to the next module")
+	}
+	if s, _ := r.states.moduleState("slow"); s != StateFailed {
+		t.Errorf("expected timed out module to be failed, got %s", s)
+	}
+}
+
+func TestRunner_ShutdownModules_TimeoutOptionOverridesInterface(t *testing.T) {
+	t.Parallel()
+	m := &mockStopTimeoutModule{mockModule: mockModule{name: "m"}, timeout: time.Hour}
+	r := newTestRunner(m)
+	r.stopTimeouts = map[string]time.Duration{"m": time.Second}
+	if got := r.stopTimeout(m); got != time.Second {
+		t.Errorf("expected option to override interface, got %v", got)
+	}
+	if got := r.stopTimeout(&mockModule{name: "other"}); got != 0 {
+		t.Errorf("expected no timeout, got %v", got)
+	}
+}
+
+func TestRunner_ShutdownModules_BudgetExhausted(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	firstStopped := false
+	first := &mockModule{name: "first", stopFn: func(ctx context.Context) error {
+		firstStopped = true
+		return nil
+	}}
+	slow := &mockModule{name: "slow", stopFn: func(ctx context.Context) error {
+		<-release
+		return nil
+	}}
+	r := newTestRunner(first, slow)
+	startTestRunner(t, r)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := r.shutdownAll(ctx)
+	if !errors.Is(err, ErrGracefulShutdownTimedOut) {
+		t.Fatalf("expected ErrGracefulShutdownTimedOut, got %v", err)
+	}
+	var timeoutErr *ShutdownTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected ShutdownTimeoutError, got %T", err)
+	}
+	if strings.Join(timeoutErr.InProgress, ",") != "slow" || strings.Join(timeoutErr.Pending, ",") != "first" {
+		t.Errorf("unexpected report: %+v", timeoutErr)
+	}
+	if len(timeoutErr.Completed) != 0 || timeoutErr.Stacks != nil {
+		t.Errorf("expected no completed modules and no stacks, got %+v", timeoutErr)
+	}
+	if firstStopped {
+		t.Error("expected modules after an exhausted budget not to be stopped")
+	}
+	if s, _ := r.states.moduleState("first"); s != StateFailed {
+		t.Errorf("expected skipped module to be failed, got %s", s)
+	}
+}
+
+func TestRunner_ShutdownModules_TimeoutReportsCompletedAndStacks(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	slow := &mockModule{name: "slow", stopFn: func(ctx context.Context) error {
+		<-release
+		return nil
+	}}
+	r := newTestRunner(slow, &mockModule{name: "done"})
+	r.dumpStacks = true
+	startTestRunner(t, r)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	var timeoutErr *ShutdownTimeoutError
+	if err := r.shutdownAll(ctx); !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected ShutdownTimeoutError, got %v", err)
+	}
+	if strings.Join(timeoutErr.Completed, ",") != "done" || strings.Join(timeoutErr.InProgress, ",") != "slow" {
+		t.Errorf("unexpected report: %+v", timeoutErr)
+	}
+	if !strings.Contains(string(timeoutErr.Stacks), "goroutine") {
+		t.Errorf("expected goroutine stack dump, got %q", timeoutErr.Stacks)
+	}
+}
+
+func TestRunner_ShutdownModules_ForceStopsTimedOutModule(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	hung := &mockForceStopModule{
+		mockModule: mockModule{name: "hung", stopFn: func(ctx context.Context) error {
+			<-release
+			return nil
+		}},
+		forceStopFn: func(ctx context.Context) error {
+			close(release)
+			return nil
+		},
+	}
+	r := newTestRunner(hung)
+	r.stopTimeouts = map[string]time.Duration{"hung": 10 * time.Millisecond}
+	startTestRunner(t, r)
+	err := r.shutdownAll(context.Background())
+	if !errors.Is(err, ErrModuleStopTimedOut) {
+		t.Fatalf("expected ErrModuleStopTimedOut, got %v", err)
+	}
+	select {
+	case <-release:
+	default:
+		t.Error("expected ForceStop to be called")
+	}
+}
+
+func TestRunner_ShutdownModules_ForceStopIsBounded(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	hung := &mockForceStopModule{
+		mockModule: mockModule{name: "hung", stopFn: func(ctx context.Context) error {
+			<-release
+			return nil
+		}},
+		forceStopFn: func(ctx context.Context) error {
+			<-release
+			return nil
+		},
+	}
+	r := newTestRunner(hung)
+	r.stopTimeouts = map[string]time.Duration{"hung": 10 * time.Millisecond}
+	startTestRunner(t, r)
+
+	begin := time.Now()
+	err := r.shutdownAll(context.Background())
+	if elapsed := time.Since(begin); elapsed > time.Second {
+		t.Errorf("expected hung ForceStop to be abandoned, took %v", elapsed)
+	}
+	if !strings.Contains(err.Error(), `force stop module "hung": module stop timed out`) {
+		t.Errorf("expected force stop timeout, got %v", err)
+	}
+}
+
+func TestRunner_ShutdownModules_ForceStopsOnBudgetExhausted(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	errForce := errors.New("force error")
+	var forced []string
+	var mu sync.Mutex
+	force := func(name string, err error) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			forced = append(forced, name)
+			return err
+		}
+	}
+	pending := &mockForceStopModule{mockModule: mockModule{name: "pending"}, forceStopFn: force("pending", nil)}
+	hung := &mockForceStopModule{
+		mockModule: mockModule{name: "hung", stopFn: func(ctx context.Context) error {
+			<-release
+			return nil
+		}},
+		forceStopFn: force("hung", errForce),
+	}
+	r := newTestRunner(pending, hung)
+	startTestRunner(t, r)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := r.shutdownAll(ctx)
+	if !errors.Is(err, ErrGracefulShutdownTimedOut) || !errors.Is(err, errForce) {
+		t.Fatalf("expected timeout and force stop errors, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `force stop module "hung"`) {
+		t.Errorf("expected module name in force stop error, got %v", err)
+	}
+	if strings.Join(forced, ",") != "hung,pending" {
+		t.Errorf("expected hung and pending modules to be force stopped, got %v", forced)
+	}
+}
+
+func TestRunner_ShutdownModules_BudgetExhaustedKeepsFailedModule(t *testing.T) {
+	t.Parallel()
+	logger := &mockLogger{}
+	r := newTestRunner(newMockBgModule("bg"), &mockModule{name: "m"})
+	r.logger = logger
+	startTestRunner(t, r)
+	r.states.failRunningModule("bg", errTest)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := r.shutdownAll(ctx); !errors.Is(err, ErrGracefulShutdownTimedOut) {
+		t.Fatalf("expected ErrGracefulShutdownTimedOut, got %v", err)
+	}
+	for _, msg := range logger.errs {
+		if msg == "invalid module state transition" {
+			t.Fatalf("unexpected invalid transition, logged %v", logger.errs)
+		}
+	}
+	if s, _ := r.states.moduleState("bg"); s != StateFailed {
+		t.Errorf("expected failed module to stay failed, got %s", s)
+	}
+}
+
+func TestRunner_ShutdownModules_NoForceStopOnSuccess(t *testing.T) {
+	t.Parallel()
+	forced := false
+	m := &mockForceStopModule{mockModule: mockModule{name: "m"}, forceStopFn: func(ctx context.Context) error {
+		forced = true
+		return nil
+	}}
+	r := newTestRunner(m)
+	startTestRunner(t, r)
+	if err := r.shutdownAll(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if forced {
+		t.Error("expected ForceStop not to be called after a graceful stop")
+	}
+}
+
+func TestRunner_StartAll_PerModuleTimeout(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	stopped := false
+	first := &mockModule{name: "first", stopFn: func(ctx context.Context) error {
+		stopped = true
+		return nil
+	}}
+	slow := &mockStartTimeoutModule{
+		mockModule: mockModule{name: "slow", startFn: func(ctx context.Context) error {
+			<-release
+			return nil
+		}},
+		timeout: 20 * time.Millisecond,
+	}
+	r := newTestRunner(first, slow)
+	if err := r.initAll(context.Background()); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	_, err := r.startAll(context.Background())
+	if !errors.Is(err, ErrModuleStartTimedOut) {
+		t.Fatalf("expected ErrModuleStartTimedOut, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `start module "slow"`) {
+		t.Errorf("expected slow module in error, got %v", err)
+	}
+	if !stopped {
+		t.Error("expected started modules to be rolled back")
+	}
+}
+
+func TestRunner_StartAll_TimedOutStartIsStoppedAfterReturning(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var events []string
+	record := func(event string) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	}
+	slow := &mockCleanerModule{
+		mockModule: mockModule{
+			name: "slow",
+			startFn: func(ctx context.Context) error {
+				<-ctx.Done()
+				record("start returned")
+				return nil
+			},
+			stopFn: func(ctx context.Context) error {
+				record("stop")
+				return nil
+			},
+		},
+		cleanupFn: func(ctx context.Context) error {
+			record("cleanup")
+			return nil
+		},
+	}
+	r := newTestRunner(slow)
+	r.startTimeouts = map[string]time.Duration{"slow": 20 * time.Millisecond}
+	if err := r.initAll(context.Background()); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	if _, err := r.startAll(context.Background()); !errors.Is(err, ErrModuleStartTimedOut) {
+		t.Fatalf("expected ErrModuleStartTimedOut, got %v", err)
+	}
+	if strings.Join(events, ",") != "start returned,stop" {
+		t.Errorf("expected Stop after Start returned, got %v", events)
+	}
+}
+
+func TestRunner_InitAll_TimedOutInitIsCleanedUp(t *testing.T) {
+	t.Parallel()
+	var cleaned atomic.Bool
+	slow := &mockCleanerModule{
+		mockModule: mockModule{name: "slow", initFn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		}},
+		cleanupFn: func(ctx context.Context) error {
+			cleaned.Store(true)
+			return nil
+		},
+	}
+	r := newTestRunner(slow)
+	ctx := withStartupBudget(context.Background(), time.Now().Add(20*time.Millisecond))
+	if err := r.initAll(ctx); !errors.Is(err, ErrStartupTimedOut) {
+		t.Fatalf("expected ErrStartupTimedOut, got %v", err)
+	}
+	if !cleaned.Load() {
+		t.Error("expected Init that completed after the timeout to be cleaned up")
+	}
+	if s, _ := r.states.moduleState("slow"); s != StateStopped {
+		t.Errorf("expected module to be stopped after rollback, got %s", s)
+	}
+}
+
+func TestRunner_StartAll_AbandonsStartIgnoringCancellation(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	var stopped, forced atomic.Bool
+	hung := &mockForceStopModule{
+		mockModule: mockModule{
+			name: "hung",
+			startFn: func(ctx context.Context) error {
+				<-release
+				return nil
+			},
+			stopFn: func(ctx context.Context) error { stopped.Store(true); return nil },
+		},
+		forceStopFn: func(ctx context.Context) error { forced.Store(true); return nil },
+	}
+	r := newTestRunner(hung)
+	r.startTimeouts = map[string]time.Duration{"hung": 20 * time.Millisecond}
+	if err := r.initAll(context.Background()); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+
+	begin := time.Now()
+	if _, err := r.startAll(context.Background()); !errors.Is(err, ErrModuleStartTimedOut) {
+		t.Fatalf("expected ErrModuleStartTimedOut, got %v", err)
+	}
+	if elapsed := time.Since(begin); elapsed > time.Second {
+		t.Errorf("expected bounded wait for cancelled Start, took %v", elapsed)
+	}
+	if stopped.Load() {
+		t.Error("expected Stop to be skipped while Start is still running")
+	}
+	if !forced.Load() {
+		t.Error("expected module still starting to be force stopped")
+	}
+}
+
+func TestRunner_InitAll_StartupBudgetExhausted(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	laterCalled := false
+	slow := &mockModule{name: "slow", initFn: func(ctx context.Context) error {
+		<-release
+		return nil
+	}}
+	later := &mockModule{name: "later", initFn: func(ctx context.Context) error {
+		laterCalled = true
+		return nil
+	}}
+	r := newTestRunner(slow, later)
+	ctx := withStartupBudget(context.Background(), time.Now().Add(20*time.Millisecond))
+	err := r.initAll(ctx)
+	if !errors.Is(err, ErrStartupTimedOut) {
+		t.Fatalf("expected ErrStartupTimedOut, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `init module "slow"`) {
+		t.Errorf("expected slow module in error, got %v", err)
+	}
+	if laterCalled {
+		t.Error("expected modules after the timeout not to be initialized")
+	}
+}
+
+func TestRunner_StartupTimeout_BudgetShorterThanModuleTimeout(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	m := &mockStartTimeoutModule{
+		mockModule: mockModule{name: "m", initFn: func(ctx context.Context) error {
+			<-release
+			return nil
+		}},
+		timeout: time.Hour,
+	}
+	r := newTestRunner(m)
+	ctx := withStartupBudget(context.Background(), time.Now().Add(20*time.Millisecond))
+	if err := r.initAll(ctx); !errors.Is(err, ErrStartupTimedOut) {
+		t.Errorf("expected ErrStartupTimedOut, got %v", err)
+	}
+}
+
+func TestRunner_InitAll_CancelledContext(t *testing.T) {
+	t.Parallel()
+	called := false
+	m := &mockModule{name: "m", initFn: func(ctx context.Context) error {
+		called = true
+		return nil
+	}}
+	r := newTestRunner(m)
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(errTest)
+	err := r.initAll(ctx)
+	if !errors.Is(err, ErrStartupAborted) || !errors.Is(err, errTest) {
+		t.Errorf("expected ErrStartupAborted with cause, got %v", err)
+	}
+	if called {
+		t.Error("expected Init not to be called after cancellation")
+	}
+}
+
+func TestRunner_InitAll_CancelledDuringInit(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &mockModule{name: "m", initFn: func(context.Context) error {
+		cancel()
+		<-release
+		return nil
+	}}
+	r := newTestRunner(m)
+	if err := r.initAll(ctx); !errors.Is(err, ErrStartupAborted) {
+		t.Errorf("expected ErrStartupAborted, got %v", err)
+	}
+}
+
+func TestRunner_StartAll_WaitsForReadyBeforeDependents(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, name)
+	}
+	server := &mockReadyModule{ready: make(chan struct{})}
+	server.mockModule = mockModule{name: "server", startFn: func(ctx context.Context) error {
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			record("server ready")
+			close(server.ready)
+		}()
+		return nil
+	}}
+	client := &mockModule{name: "client", deps: []string{"server"}, startFn: func(ctx context.Context) error {
+		record("client")
+		return nil
+	}}
+	r := newParallelTestRunner(0, server, client)
+	r.readyTimeout = time.Second
+	if err := r.initAll(context.Background()); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	if _, err := r.startAll(context.Background()); err != nil {
+		t.Fatalf("unexpected start error: %v", err)
+	}
+	if strings.Join(order, ",") != "server ready,client" {
+		t.Errorf("expected dependent to start after readiness, got %v", order)
+	}
+}
+
+func TestRunner_StartAll_ReadyTimeout(t *testing.T) {
+	t.Parallel()
+	stopped := false
+	m := &mockReadyModule{
+		mockModule: mockModule{name: "never", stopFn: func(ctx context.Context) error {
+			stopped = true
+			return nil
+		}},
+		ready: make(chan struct{}),
+	}
+	r := newTestRunner(m)
+	r.readyTimeout = 20 * time.Millisecond
+	if err := r.initAll(context.Background()); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	_, err := r.startAll(context.Background())
+	if !errors.Is(err, ErrModuleNotReady) || !strings.Contains(err.Error(), `start module "never"`) {
+		t.Fatalf("expected ErrModuleNotReady for module, got %v", err)
+	}
+	if !stopped {
+		t.Error("expected module that started but never became ready to be stopped")
+	}
+	if s, _ := r.states.moduleState("never"); s != StateStopped {
+		t.Errorf("expected module to be stopped after rollback, got %s", s)
+	}
+}
+
+func TestRunner_StartAll_ReadyUsesStartupBudget(t *testing.T) {
+	t.Parallel()
+	r := newTestRunner(&mockReadyModule{mockModule: mockModule{name: "m"}, ready: make(chan struct{})})
+	r.readyTimeout = time.Hour
+	ctx := withStartupBudget(context.Background(), time.Now().Add(20*time.Millisecond))
+	if err := r.initAll(ctx); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	if _, err := r.startAll(ctx); !errors.Is(err, ErrStartupTimedOut) {
+		t.Errorf("expected ErrStartupTimedOut, got %v", err)
+	}
+}
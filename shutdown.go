@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+type shutdownBudgetKeyType struct{}
+
+var contextKeyShutdownBudget = shutdownBudgetKeyType{}
+
+type ShutdownTimeoutError struct {
+	Completed  []string
+	InProgress []string
+	Pending    []string
+	Stacks     []byte
+}
+
+func (e *ShutdownTimeoutError) Error() string {
+	return fmt.Sprintf("%s: completed %q, in progress %q, pending %q",
+		ErrGracefulShutdownTimedOut, e.Completed, e.InProgress, e.Pending)
+}
+
+func (e *ShutdownTimeoutError) Unwrap() error {
+	return ErrGracefulShutdownTimedOut
+}
+
+type shutdownReport struct {
+	mu         sync.Mutex
+	expired    bool
+	stacks     []byte
+	completed  []string
+	inProgress []string
+	pending    []string
+}
+
+func (r *shutdownReport) timedOut(dumpStacks bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.expired {
+		return
+	}
+	r.expired = true
+	if dumpStacks {
+		r.stacks = goroutineStacks()
+	}
+}
+
+func (r *shutdownReport) add(list *[]string, module string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*list = append(*list, module)
+}
+
+func (r *shutdownReport) err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.expired {
+		return nil
+	}
+	return &ShutdownTimeoutError{
+		Completed:  r.completed,
+		InProgress: r.inProgress,
+		Pending:    r.pending,
+		Stacks:     r.stacks,
+	}
+}
+
+func goroutineStacks() []byte {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+func withShutdownBudget(ctx context.Context) context.Context {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKeyShutdownBudget, deadline)
+}
+
+func ShutdownBudgetFromContext(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Value(contextKeyShutdownBudget).(time.Time)
+	if !ok {
+		return 0, false
+	}
+	return max(time.Until(deadline), 0), true
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestShutdownBudgetFromContext(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	budget, ok := ShutdownBudgetFromContext(withShutdownBudget(ctx))
+	if !ok {
+		t.Fatal("expected budget to be present")
+	}
+	if budget <= 0 || budget > time.Minute {
+		t.Errorf("expected remaining budget within a minute, got %v", budget)
+	}
+}
+
+func TestShutdownBudgetFromContext_Missing(t *testing.T) {
+	t.Parallel()
+	if _, ok := ShutdownBudgetFromContext(context.Background()); ok {
+		t.Error("expected no budget in plain context")
+	}
+	if _, ok := ShutdownBudgetFromContext(withShutdownBudget(context.Background())); ok {
+		t.Error("expected no budget without deadline")
+	}
+}
+
+func TestShutdownBudgetFromContext_Exhausted(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	budget, ok := ShutdownBudgetFromContext(withShutdownBudget(ctx))
+	if !ok || budget != 0 {
+		t.Errorf("expected exhausted budget, got %v %v", budget, ok)
+	}
+}
+
+func TestShutdownTimeoutError(t *testing.T) {
+	t.Parallel()
+	err := &ShutdownTimeoutError{Completed: []string{"a"}, InProgress: []string{"b"}, Pending: []string{"c"}}
+	if !errors.Is(err, ErrGracefulShutdownTimedOut) {
+		t.Error("expected error to unwrap to ErrGracefulShutdownTimedOut")
+	}
+	expected := `graceful shutdown timed out: completed ["a"], in progress ["b"], pending ["c"]`
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestShutdownReport_NotExpired(t *testing.T) {
+	t.Parallel()
+	r := &shutdownReport{}
+	r.add(&r.completed, "a")
+	if err := r.err(); err != nil {
+		t.Errorf("expected no error without timeout, got %v", err)
+	}
+}
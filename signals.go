@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+type SignalHandler func(ctx context.Context, sig os.Signal)
+
+func defaultSignals() []os.Signal {
+	return []os.Signal{syscall.SIGINT, syscall.SIGTERM}
+}
+
+func (a *Application) handleSignals(ctx context.Context, cancel context.CancelCauseFunc) (<-chan os.Signal, func()) {
+	if !a.signalHandling {
+		return nil, func() {}
+	}
+
+	sigs := append([]os.Signal{}, a.signals...)
+	for sig := range a.signalHandlers {
+		sigs = append(sigs, sig)
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, sigs...)
+
+	forced := make(chan os.Signal, 1)
+	quit := make(chan struct{})
+	go func() {
+		defer signal.Stop(sigChan)
+		a.watchSignals(ctx, cancel, sigChan, forced, quit)
+	}()
+	return forced, func() { close(quit) }
+}
+
+func (a *Application) watchSignals(
+	ctx context.Context,
+	cancel context.CancelCauseFunc,
+	sigChan <-chan os.Signal,
+	forced chan<- os.Signal,
+	quit <-chan struct{},
+) {
+	terminating := false
+	for {
+		select {
+		case sig := <-sigChan:
+			if handler, ok := a.signalHandlers[sig]; ok {
+				a.logger.Info("received signal, running handler", "signal", sig.String())
+				go handler(ctx, sig)
+				continue
+			}
+
+			if !terminating {
+				terminating = true
+				a.logger.Info("received signal", "signal", sig.String())
+				cancel(&ShutdownCause{Reason: ShutdownReasonSignal, Signal: sig})
+				continue
+			}
+
+			if !a.signalForceExit {
+				a.logger.Info("received signal during shutdown, ignoring", "signal", sig.String())
+				continue
+			}
+			a.logger.Error("received signal during shutdown, forcing exit", "signal", sig.String())
+			forced <- sig
+			return
+		case <-quit:
+			return
+		}
+	}
+}
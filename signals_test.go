@@ -0,0 +1,213 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func startWatchingSignals(ctx context.Context, cancel context.CancelCauseFunc, a *Application) (chan os.Signal, chan os.Signal, func()) {
+	sigChan := make(chan os.Signal, 1)
+	forced := make(chan os.Signal, 1)
+	quit := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		a.watchSignals(ctx, cancel, sigChan, forced, quit)
+	}()
+	return sigChan, forced, func() {
+		close(quit)
+		<-done
+	}
+}
+
+func TestWatchSignals_FirstSignalCancels(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	sigChan, _, stop := startWatchingSignals(ctx, cancel, a)
+	defer stop()
+
+	sigChan <- syscall.SIGTERM
+	<-ctx.Done()
+	cause := causeFromContext(ctx)
+	if cause.Reason != ShutdownReasonSignal || cause.Signal != syscall.SIGTERM {
+		t.Errorf("unexpected cause: %+v", cause)
+	}
+}
+
+func TestWatchSignals_SecondSignalForcesExit(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	sigChan, forced, stop := startWatchingSignals(ctx, cancel, a)
+	defer stop()
+
+	sigChan <- syscall.SIGTERM
+	<-ctx.Done()
+	select {
+	case <-forced:
+		t.Fatal("expected first signal not to force exit")
+	default:
+	}
+
+	sigChan <- syscall.SIGINT
+	select {
+	case sig := <-forced:
+		if sig != syscall.SIGINT {
+			t.Errorf("expected SIGINT, got %v", sig)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected forced exit")
+	}
+}
+
+func TestWatchSignals_FirstSignalAfterStopDoesNotForceExit(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(&ShutdownCause{Reason: ShutdownReasonStopRequested})
+	sigChan, forced, stop := startWatchingSignals(ctx, cancel, a)
+	defer stop()
+
+	sigChan <- syscall.SIGTERM
+	select {
+	case <-forced:
+		t.Fatal("expected first signal during shutdown not to force exit")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if cause := causeFromContext(ctx); cause.Reason != ShutdownReasonStopRequested {
+		t.Errorf("expected original cause to be kept, got %+v", cause)
+	}
+
+	sigChan <- syscall.SIGTERM
+	select {
+	case <-forced:
+	case <-time.After(time.Second):
+		t.Fatal("expected second signal to force exit")
+	}
+}
+
+func TestWatchSignals_SecondSignalIgnoredWhenDisabled(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithForceExitOnSecondSignal(false))
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	sigChan, forced, stop := startWatchingSignals(ctx, cancel, a)
+	defer stop()
+
+	sigChan <- syscall.SIGINT
+	<-ctx.Done()
+	sigChan <- syscall.SIGINT
+	select {
+	case <-forced:
+		t.Fatal("expected second signal to be ignored")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWatchSignals_CustomHandler(t *testing.T) {
+	t.Parallel()
+	received := make(chan os.Signal, 1)
+	a := newTestApp(WithSignalHandler(syscall.SIGUSR1, func(ctx context.Context, sig os.Signal) {
+		received <- sig
+	}))
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	sigChan, _, stop := startWatchingSignals(ctx, cancel, a)
+	defer stop()
+
+	sigChan <- syscall.SIGUSR1
+	select {
+	case sig := <-received:
+		if sig != syscall.SIGUSR1 {
+			t.Errorf("expected SIGUSR1, got %v", sig)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected handler to be called")
+	}
+	if ctx.Err() != nil {
+		t.Error("expected custom signal not to trigger shutdown")
+	}
+}
+
+func TestHandleSignals_Disabled(t *testing.T) {
+	t.Parallel()
+	a := newTestApp(WithoutSignalHandling())
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	forced, stop := a.handleSignals(ctx, cancel)
+	defer stop()
+	if forced != nil {
+		t.Error("expected no forced channel when signal handling is disabled")
+	}
+}
+
+func TestApplication_Run_RepeatedSignalForcesExit(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	a := newTestApp(WithSignals(syscall.SIGUSR2), WithHook(Hook{
+		BeforeStop: func(ctx context.Context) error {
+			<-release
+			return nil
+		},
+	}))
+	cancel, errCh := runInBackground(a)
+	defer cancel()
+	waitFor(t, isRunning(a))
+
+	_ = syscall.Kill(os.Getpid(), syscall.SIGUSR2)
+	waitFor(t, func() bool { return a.State() == StateStopping })
+	_ = syscall.Kill(os.Getpid(), syscall.SIGUSR2)
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, ErrShutdownForced) {
+			t.Errorf("expected ErrShutdownForced, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected Run to return after repeated signal")
+	}
+	if a.State() != StateFailed {
+		t.Errorf("expected failed state, got %s", a.State())
+	}
+}
+
+func TestApplication_Run_RepeatedSignalForcesExitDuringStartup(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	initializing := make(chan struct{})
+	cancelled := make(chan struct{})
+	a := newTestApp(WithSignals(syscall.SIGUSR2))
+	_ = a.Register(&mockModule{name: "hung", initFn: func(ctx context.Context) error {
+		close(initializing)
+		<-ctx.Done()
+		close(cancelled)
+		<-release
+		return nil
+	}})
+	cancel, errCh := runInBackground(a)
+	defer cancel()
+
+	<-initializing
+	_ = syscall.Kill(os.Getpid(), syscall.SIGUSR2)
+	<-cancelled
+	_ = syscall.Kill(os.Getpid(), syscall.SIGUSR2)
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, ErrShutdownForced) {
+			t.Errorf("expected ErrShutdownForced, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected Run to return after repeated signal during startup")
+	}
+	if a.State() != StateFailed {
+		t.Errorf("expected failed state, got %s", a.State())
+	}
+}
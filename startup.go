@@ -0,0 +1,22 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+type startupBudgetKeyType struct{}
+
+var contextKeyStartupBudget = startupBudgetKeyType{}
+
+func withStartupBudget(ctx context.Context, deadline time.Time) context.Context {
+	return context.WithValue(ctx, contextKeyStartupBudget, deadline)
+}
+
+func StartupBudgetFromContext(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Value(contextKeyStartupBudget).(time.Time)
+	if !ok {
+		return 0, false
+	}
+	return max(time.Until(deadline), 0), true
+}
@@ -0,0 +1,22 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestStartupBudgetFromContext(t *testing.T) {
+	t.Parallel()
+	budget, ok := StartupBudgetFromContext(withStartupBudget(context.Background(), time.Now().Add(time.Minute)))
+	if !ok || budget <= 0 || budget > time.Minute {
+		t.Errorf("expected remaining budget within a minute, got %v %v", budget, ok)
+	}
+	budget, ok = StartupBudgetFromContext(withStartupBudget(context.Background(), time.Now().Add(-time.Second)))
+	if !ok || budget != 0 {
+		t.Errorf("expected exhausted budget, got %v %v", budget, ok)
+	}
+	if _, ok := StartupBudgetFromContext(context.Background()); ok {
+		t.Error("expected no budget in plain context")
+	}
+}
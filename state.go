@@ -0,0 +1,160 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type State int
+
+const (
+	StateCreated State = iota
+	StateInitializing
+	StateInitialized
+	StateStarting
+	StateRunning
+	StateStopping
+	StateStopped
+	StateFailed
+)
+
+func (s State) String() string {
+	switch s {
+	case StateCreated:
+		return "created"
+	case StateInitializing:
+		return "initializing"
+	case StateInitialized:
+		return "initialized"
+	case StateStarting:
+		return "starting"
+	case StateRunning:
+		return "running"
+	case StateStopping:
+		return "stopping"
+	case StateStopped:
+		return "stopped"
+	case StateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+type StateEvent struct {
+	Module string
+	From   State
+	To     State
+	Time   time.Time
+	Err    error
+}
+
+var appTransitions = map[State][]State{
+	StateCreated:      {StateInitializing, StateFailed},
+	StateInitializing: {StateInitialized, StateFailed},
+	StateInitialized:  {StateStarting, StateFailed},
+	StateStarting:     {StateRunning, StateFailed},
+	StateRunning:      {StateStopping},
+	StateStopping:     {StateStopped, StateFailed},
+}
+
+var moduleTransitions = map[State][]State{
+	StateCreated:      {StateInitializing},
+	StateInitializing: {StateInitialized, StateFailed},
+	StateInitialized:  {StateStarting, StateStopping},
+	StateStarting:     {StateRunning, StateFailed},
+	StateRunning:      {StateStopping, StateFailed},
+	StateStopping:     {StateStopped, StateFailed},
+	StateStopped:      {StateInitializing},
+	StateFailed:       {StateInitializing, StateStopping},
+}
+
+func canTransition(table map[State][]State, from, to State) bool {
+	for _, allowed := range table[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+type stateTracker struct {
+	mu      sync.RWMutex
+	app     State
+	modules map[string]State
+	watch   broadcaster[StateEvent]
+}
+
+func newStateTracker() *stateTracker {
+	return &stateTracker{modules: make(map[string]State)}
+}
+
+func (t *stateTracker) appState() State {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.app
+}
+
+func (t *stateTracker) moduleState(name string) (State, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	s, ok := t.modules[name]
+	return s, ok
+}
+
+func (t *stateTracker) moduleStates() map[string]State {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	result := make(map[string]State, len(t.modules))
+	for name, s := range t.modules {
+		result[name] = s
+	}
+	return result
+}
+
+func (t *stateTracker) addModule(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.modules[name] = StateCreated
+}
+
+func (t *stateTracker) transitionApp(to State, err error) error {
+	t.mu.Lock()
+	from := t.app
+	if !canTransition(appTransitions, from, to) {
+		t.mu.Unlock()
+		return fmt.Errorf("%w: application %s -> %s", ErrInvalidStateTransition, from, to)
+	}
+	t.app = to
+	t.mu.Unlock()
+
+	t.watch.publish(StateEvent{From: from, To: to, Time: time.Now(), Err: err})
+	return nil
+}
+
+func (t *stateTracker) transitionModule(name string, to State, err error) error {
+	t.mu.Lock()
+	from := t.modules[name]
+	if !canTransition(moduleTransitions, from, to) {
+		t.mu.Unlock()
+		return fmt.Errorf("%w: module %q %s -> %s", ErrInvalidStateTransition, name, from, to)
+	}
+	t.modules[name] = to
+	t.mu.Unlock()
+
+	t.watch.publish(StateEvent{Module: name, From: from, To: to, Time: time.Now(), Err: err})
+	return nil
+}
+
+func (t *stateTracker) failRunningModule(name string, err error) {
+	t.mu.Lock()
+	if t.modules[name] != StateRunning {
+		t.mu.Unlock()
+		return
+	}
+	t.modules[name] = StateFailed
+	t.mu.Unlock()
+
+	t.watch.publish(StateEvent{Module: name, From: StateRunning, To: StateFailed, Time: time.Now(), Err: err})
+}
@@ -0,0 +1,181 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestState_String(t *testing.T) {
+	t.Parallel()
+	cases := map[State]string{
+		StateCreated:      "created",
+		StateInitializing: "initializing",
+		StateInitialized:  "initialized",
+		StateStarting:     "starting",
+		StateRunning:      "running",
+		StateStopping:     "stopping",
+		StateStopped:      "stopped",
+		StateFailed:       "failed",
+		State(99):         "unknown",
+	}
+	for s, expected := range cases {
+		if s.String() != expected {
+			t.Errorf("expected %q, got %q", expected, s.String())
+		}
+	}
+}
+
+func TestStateTracker_AppTransitions(t *testing.T) {
+	t.Parallel()
+	tr := newStateTracker()
+	for _, to := range []State{StateInitializing, StateInitialized, StateStarting, StateRunning, StateStopping, StateStopped} {
+		if err := tr.transitionApp(to, nil); err != nil {
+			t.Fatalf("unexpected error moving to %s: %v", to, err)
+		}
+	}
+	if err := tr.transitionApp(StateInitializing, nil); !errors.Is(err, ErrInvalidStateTransition) {
+		t.Errorf("expected ErrInvalidStateTransition from terminal state, got %v", err)
+	}
+	if tr.appState() != StateStopped {
+		t.Errorf("expected state to stay stopped, got %s", tr.appState())
+	}
+}
+
+func TestStateTracker_ModuleInvalidTransition(t *testing.T) {
+	t.Parallel()
+	tr := newStateTracker()
+	tr.addModule("m1")
+	err := tr.transitionModule("m1", StateRunning, nil)
+	if !errors.Is(err, ErrInvalidStateTransition) {
+		t.Fatalf("expected ErrInvalidStateTransition, got %v", err)
+	}
+	if s, _ := tr.moduleState("m1"); s != StateCreated {
+		t.Errorf("expected module to stay created, got %s", s)
+	}
+}
+
+func TestStateTracker_Events(t *testing.T) {
+	t.Parallel()
+	tr := newStateTracker()
+	tr.addModule("m1")
+	events, cancel := tr.watch.subscribe()
+	defer cancel()
+	_ = tr.transitionModule("m1", StateInitializing, nil)
+	_ = tr.transitionModule("m1", StateFailed, errTest)
+	first := <-events
+	if first.Module != "m1" || first.From != StateCreated || first.To != StateInitializing || first.Time.IsZero() {
+		t.Errorf("unexpected event: %+v", first)
+	}
+	second := <-events
+	if second.To != StateFailed || !errors.Is(second.Err, errTest) {
+		t.Errorf("unexpected event: %+v", second)
+	}
+}
+
+func TestStateTracker_FailRunningModule(t *testing.T) {
+	t.Parallel()
+	tr := newStateTracker()
+	tr.addModule("m1")
+	tr.failRunningModule("m1", errTest)
+	if s, _ := tr.moduleState("m1"); s != StateCreated {
+		t.Errorf("expected non-running module to be untouched, got %s", s)
+	}
+	tr.modules["m1"] = StateRunning
+	tr.failRunningModule("m1", errTest)
+	if s, _ := tr.moduleState("m1"); s != StateFailed {
+		t.Errorf("expected failed, got %s", s)
+	}
+}
+
+func TestApplication_StateLifecycle(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "m1"})
+	if a.State() != StateCreated {
+		t.Errorf("expected created, got %s", a.State())
+	}
+	if s, ok := a.ModuleState("m1"); !ok || s != StateCreated {
+		t.Errorf("expected registered module to be created, got %s", s)
+	}
+	if _, ok := a.ModuleState("missing"); ok {
+		t.Error("expected unknown module to be reported as missing")
+	}
+
+	events, cancel := a.WatchState()
+	defer cancel()
+	ctx, ctxCancel := quickCancelCtx()
+	defer ctxCancel()
+	if err := a.Run(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var appStates, moduleStates []State
+	for len(events) > 0 {
+		e := <-events
+		if e.Module == "" {
+			appStates = append(appStates, e.To)
+		} else {
+			moduleStates = append(moduleStates, e.To)
+		}
+	}
+	expectedApp := []State{StateInitializing, StateInitialized, StateStarting, StateRunning, StateStopping, StateStopped}
+	expectedModule := []State{StateInitializing, StateInitialized, StateStarting, StateRunning, StateStopping, StateStopped}
+	if !equalStates(appStates, expectedApp) {
+		t.Errorf("expected app states %v, got %v", expectedApp, appStates)
+	}
+	if !equalStates(moduleStates, expectedModule) {
+		t.Errorf("expected module states %v, got %v", expectedModule, moduleStates)
+	}
+	if states := a.ModuleStates(); states["m1"] != StateStopped {
+		t.Errorf("expected m1 stopped, got %v", states)
+	}
+}
+
+func TestApplication_StateFailedOnInitError(t *testing.T) {
+	t.Parallel()
+	a := newTestApp()
+	_ = a.Register(&mockModule{name: "bad", initFn: func(ctx context.Context) error { return errTest }})
+	_ = a.Run(context.Background())
+	if a.State() != StateFailed {
+		t.Errorf("expected failed, got %s", a.State())
+	}
+	if s, _ := a.ModuleState("bad"); s != StateFailed {
+		t.Errorf("expected module failed, got %s", s)
+	}
+}
+
+func TestApplication_StateBackgroundFailureMarksModule(t *testing.T) {
+	t.Parallel()
+	bg := newMockBgModule("bg")
+	bg.startFn = func(ctx context.Context) error {
+		bg.errCh <- errTest
+		return nil
+	}
+	a := newTestApp()
+	_ = a.Register(bg)
+	events, cancel := a.WatchState()
+	defer cancel()
+	_ = a.Run(context.Background())
+	failed := false
+	for len(events) > 0 {
+		if e := <-events; e.Module == "bg" && e.To == StateFailed && errors.Is(e.Err, errTest) {
+			failed = true
+		}
+	}
+	if !failed {
+		t.Error("expected background failure to be observed as a failed transition")
+	}
+}
+
+func equalStates(a, b []State) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,235 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+type RestartMode int
+
+const (
+	RestartNever RestartMode = iota
+	RestartOnFailure
+	RestartAlways
+)
+
+type SupervisionStrategy int
+
+const (
+	OneForOne SupervisionStrategy = iota
+	OneForAll
+	RestForOne
+)
+
+type Backoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+	Jitter     float64
+}
+
+type RestartPolicy struct {
+	Mode        RestartMode
+	MaxRestarts int
+	Window      time.Duration
+	Backoff     Backoff
+}
+
+var errModuleExited = errors.New("background module exited")
+
+func (p RestartPolicy) validate() error {
+	switch {
+	case p.Mode < RestartNever || p.Mode > RestartAlways:
+		return fmt.Errorf("%w: unknown restart mode %d", ErrInvalidRestartPolicy, p.Mode)
+	case p.MaxRestarts < 0:
+		return fmt.Errorf("%w: max restarts must be positive or zero", ErrInvalidRestartPolicy)
+	case p.Window < 0:
+		return fmt.Errorf("%w: window must be positive or zero", ErrInvalidRestartPolicy)
+	case p.Backoff.Initial < 0 || p.Backoff.Max < 0:
+		return fmt.Errorf("%w: backoff durations must be positive or zero", ErrInvalidRestartPolicy)
+	case p.Backoff.Multiplier < 0:
+		return fmt.Errorf("%w: backoff multiplier must be positive or zero", ErrInvalidRestartPolicy)
+	case p.Backoff.Jitter < 0 || p.Backoff.Jitter > 1:
+		return fmt.Errorf("%w: backoff jitter must be between 0 and 1", ErrInvalidRestartPolicy)
+	}
+	return nil
+}
+
+func (p RestartPolicy) restarts(err error) bool {
+	switch p.Mode {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return !errors.Is(err, errModuleExited)
+	default:
+		return false
+	}
+}
+
+func (b Backoff) delay(attempt int) time.Duration {
+	if b.Initial <= 0 {
+		return 0
+	}
+
+	d := float64(b.Initial)
+	if b.Multiplier > 1 {
+		d *= math.Pow(b.Multiplier, float64(attempt))
+	}
+	if b.Max > 0 && d > float64(b.Max) {
+		d = float64(b.Max)
+	}
+	if b.Jitter > 0 {
+		d += d * b.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(d)
+}
+
+func (s *supervisor) handle(event backgroundEvent) {
+	name := event.module.Name()
+
+	if s.isStale(event) {
+		return
+	}
+	halted := s.isHalted()
+
+	if event.err != errModuleExited {
+		s.runner.states.failRunningModule(name, event.err)
+	}
+
+	policy, supervised := s.policies[name]
+	if !supervised || halted || !policy.restarts(event.err) {
+		s.record(name, event.err)
+		return
+	}
+
+	attempt, allowed := s.allowRestart(name, policy)
+	if !allowed {
+		s.record(name, fmt.Errorf("%w: %w", ErrRestartLimitExceeded, event.err))
+		return
+	}
+
+	delay := policy.Backoff.delay(attempt)
+	s.logger.Error("restarting background module", "module", name, "error", event.err, "attempt", attempt+1, "delay", delay)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.restartAfter(event, delay)
+	}()
+}
+
+func (s *supervisor) restartAfter(event backgroundEvent, delay time.Duration) {
+	name := event.module.Name()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-s.halting:
+		s.record(name, event.err)
+		return
+	}
+
+	s.restartMu.Lock()
+	defer s.restartMu.Unlock()
+
+	if s.isHalted() {
+		s.record(name, event.err)
+		return
+	}
+	if s.isStale(event) {
+		return
+	}
+
+	affected := s.affected(name)
+	if err := s.restart(affected); err != nil {
+		s.record(name, fmt.Errorf("restart failed: %w", errors.Join(event.err, err)))
+		return
+	}
+	s.logger.Info("background module restarted", "module", name)
+}
+
+func (s *supervisor) allowRestart(name string, policy RestartPolicy) (attempt int, allowed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	history := s.restarts[name]
+	if policy.Window > 0 {
+		kept := history[:0]
+		for _, t := range history {
+			if now.Sub(t) <= policy.Window {
+				kept = append(kept, t)
+			}
+		}
+		history = kept
+	}
+
+	if policy.MaxRestarts > 0 && len(history) >= policy.MaxRestarts {
+		s.restarts[name] = history
+		return len(history), false
+	}
+
+	s.restarts[name] = append(history, now)
+	return len(history), true
+}
+
+func (s *supervisor) affected(name string) []Module {
+	modules := s.runner.registry.getAll()
+	switch s.strategy {
+	case OneForAll:
+		return modules
+	case RestForOne:
+		for i, m := range modules {
+			if m.Name() == name {
+				return modules[i:]
+			}
+		}
+	}
+	for _, m := range modules {
+		if m.Name() == name {
+			return []Module{m}
+		}
+	}
+	return nil
+}
+
+func (s *supervisor) restart(modules []Module) error {
+	s.mu.Lock()
+	for _, m := range modules {
+		s.generations[m.Name()]++
+	}
+	s.mu.Unlock()
+
+	stopCtx := context.Background()
+	if s.stopTimeout > 0 {
+		var cancel context.CancelFunc
+		stopCtx, cancel = context.WithTimeout(stopCtx, s.stopTimeout)
+		defer cancel()
+	}
+
+	err := s.runner.restartModules(s.ctx, stopCtx, modules)
+
+	for _, m := range modules {
+		if bg, ok := m.(BackgroundModule); ok {
+			s.watch(bg)
+		}
+	}
+	return err
+}
+
+func (s *supervisor) isHalted() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.halted
+}
+
+func (s *supervisor) isStale(event backgroundEvent) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return event.generation != s.generations[event.module.Name()]
+}
@@ -0,0 +1,264 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newFlakyBgModule(name string, failures int32) (*mockBgModule, *atomic.Int32) {
+	bg := newMockBgModule(name)
+	var starts atomic.Int32
+	bg.startFn = func(ctx context.Context) error {
+		if starts.Add(1) <= failures {
+			bg.errCh <- errTest
+		}
+		return nil
+	}
+	return bg, &starts
+}
+
+func TestBackoff_Delay(t *testing.T) {
+	t.Parallel()
+	b := Backoff{Initial: 10 * time.Millisecond, Max: 50 * time.Millisecond, Multiplier: 2}
+	expected := []time.Duration{10, 20, 40, 50, 50}
+	for attempt, want := range expected {
+		if got := b.delay(attempt); got != want*time.Millisecond {
+			t.Errorf("attempt %d: expected %v, got %v", attempt, want*time.Millisecond, got)
+		}
+	}
+	if d := (Backoff{}).delay(3); d != 0 {
+		t.Errorf("expected zero delay without initial backoff, got %v", d)
+	}
+}
+
+func TestBackoff_Jitter(t *testing.T) {
+	t.Parallel()
+	b := Backoff{Initial: 100 * time.Millisecond, Jitter: 0.5}
+	for range 100 {
+		if d := b.delay(0); d < 50*time.Millisecond || d > 150*time.Millisecond {
+			t.Fatalf("expected delay within jitter bounds, got %v", d)
+		}
+	}
+}
+
+func TestRestartPolicy_Validate(t *testing.T) {
+	t.Parallel()
+	invalid := []RestartPolicy{
+		{Mode: RestartMode(42)},
+		{Mode: RestartOnFailure, MaxRestarts: -1},
+		{Mode: RestartOnFailure, Window: -time.Second},
+		{Mode: RestartOnFailure, Backoff: Backoff{Initial: -time.Second}},
+		{Mode: RestartOnFailure, Backoff: Backoff{Multiplier: -1}},
+		{Mode: RestartOnFailure, Backoff: Backoff{Jitter: 2}},
+	}
+	for _, p := range invalid {
+		if err := p.validate(); !errors.Is(err, ErrInvalidRestartPolicy) {
+			t.Errorf("expected ErrInvalidRestartPolicy for %+v, got %v", p, err)
+		}
+	}
+	if err := (RestartPolicy{Mode: RestartAlways, MaxRestarts: 3, Window: time.Minute}).validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSupervisor_OneForOneRestart(t *testing.T) {
+	t.Parallel()
+	bg, starts := newFlakyBgModule("worker", 1)
+	var inits, stops atomic.Int32
+	bg.initFn = func(ctx context.Context) error { inits.Add(1); return nil }
+	bg.stopFn = func(ctx context.Context) error { stops.Add(1); return nil }
+	var otherStarts atomic.Int32
+	other := &mockModule{name: "other", startFn: func(ctx context.Context) error { otherStarts.Add(1); return nil }}
+
+	a := newTestApp(WithRestartPolicy("worker", RestartPolicy{Mode: RestartOnFailure}))
+	_ = a.Register(bg)
+	_ = a.Register(other)
+	cancel, done := runInBackground(a)
+	waitFor(t, func() bool { return starts.Load() == 2 })
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("expected handled failure not to be reported, got %v", err)
+	}
+	if inits.Load() != 2 || stops.Load() != 2 {
+		t.Errorf("expected stop/init/start cycle, got inits=%d stops=%d", inits.Load(), stops.Load())
+	}
+	if otherStarts.Load() != 1 {
+		t.Errorf("expected other module untouched, got %d starts", otherStarts.Load())
+	}
+}
+
+func TestSupervisor_RestartLimitEscalates(t *testing.T) {
+	t.Parallel()
+	bg, starts := newFlakyBgModule("worker", 100)
+	a := newTestApp(WithRestartPolicy("worker", RestartPolicy{
+		Mode:        RestartOnFailure,
+		MaxRestarts: 2,
+		Window:      time.Minute,
+		Backoff:     Backoff{Initial: time.Millisecond, Multiplier: 2},
+	}))
+	_ = a.Register(bg)
+	_, done := runInBackground(a)
+	var err error
+	select {
+	case err = <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected application to shut down")
+	}
+	if !errors.Is(err, ErrRestartLimitExceeded) || !errors.Is(err, errTest) {
+		t.Errorf("expected restart limit error, got %v", err)
+	}
+	if starts.Load() != 3 {
+		t.Errorf("expected 1 start and 2 restarts, got %d", starts.Load())
+	}
+}
+
+func TestSupervisor_AlwaysRestartsOnExit(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	bg := &mockBgModule{mockModule: mockModule{name: "poller"}}
+	var starts atomic.Int32
+	bg.startFn = func(ctx context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+		bg.errCh = make(chan error)
+		if starts.Add(1) == 1 {
+			close(bg.errCh)
+		}
+		return nil
+	}
+	a := newTestApp(WithRestartPolicy("poller", RestartPolicy{Mode: RestartAlways}))
+	_ = a.Register(&lockedBgModule{mockBgModule: bg, mu: &mu})
+	cancel, done := runInBackground(a)
+	waitFor(t, func() bool { return starts.Load() == 2 })
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSupervisor_OnFailureIgnoresExit(t *testing.T) {
+	t.Parallel()
+	bg := newMockBgModule("worker")
+	var starts atomic.Int32
+	bg.startFn = func(ctx context.Context) error {
+		starts.Add(1)
+		close(bg.errCh)
+		return nil
+	}
+	a := newTestApp(WithRestartPolicy("worker", RestartPolicy{Mode: RestartOnFailure}))
+	_ = a.Register(bg)
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	if err := a.Run(ctx); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if starts.Load() != 1 {
+		t.Errorf("expected no restart on clean exit, got %d starts", starts.Load())
+	}
+}
+
+func TestSupervisor_Strategies(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		strategy SupervisionStrategy
+		expected map[string]int32
+	}{
+		{"one for one", OneForOne, map[string]int32{"first": 1, "worker": 2, "last": 1}},
+		{"one for all", OneForAll, map[string]int32{"first": 2, "worker": 2, "last": 2}},
+		{"rest for one", RestForOne, map[string]int32{"first": 1, "worker": 2, "last": 2}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var firstStarts, lastStarts atomic.Int32
+			bg, workerStarts := newFlakyBgModule("worker", 1)
+			a := newTestApp(
+				WithSupervisionStrategy(tc.strategy),
+				WithRestartPolicy("worker", RestartPolicy{Mode: RestartOnFailure}),
+			)
+			_ = a.Register(&mockModule{name: "first", startFn: func(ctx context.Context) error { firstStarts.Add(1); return nil }})
+			_ = a.Register(bg)
+			_ = a.Register(&mockModule{name: "last", startFn: func(ctx context.Context) error { lastStarts.Add(1); return nil }})
+			cancel, done := runInBackground(a)
+			waitFor(t, func() bool { return workerStarts.Load() == 2 })
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+			if err := <-done; err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := map[string]int32{"first": firstStarts.Load(), "worker": workerStarts.Load(), "last": lastStarts.Load()}
+			for name, want := range tc.expected {
+				if got[name] != want {
+					t.Errorf("expected %s to start %d times, got %d", name, want, got[name])
+				}
+			}
+		})
+	}
+}
+
+func TestSupervisor_NoRestartWhenHalted(t *testing.T) {
+	t.Parallel()
+	bg := newMockBgModule("worker")
+	var starts atomic.Int32
+	bg.startFn = func(ctx context.Context) error { starts.Add(1); return nil }
+	bg.stopFn = func(ctx context.Context) error {
+		bg.errCh <- errTest
+		return nil
+	}
+	a := newTestApp(WithRestartPolicy("worker", RestartPolicy{Mode: RestartOnFailure}))
+	_ = a.Register(bg)
+	ctx, cancel := quickCancelCtx()
+	defer cancel()
+	err := a.Run(ctx)
+	if !errors.Is(err, errTest) {
+		t.Errorf("expected error reported during shutdown, got %v", err)
+	}
+	if starts.Load() != 1 {
+		t.Errorf("expected no restart during shutdown, got %d starts", starts.Load())
+	}
+}
+
+func TestSupervisor_BackoffDoesNotDelayEscalation(t *testing.T) {
+	t.Parallel()
+	worker, starts := newFlakyBgModule("worker", 1)
+	crasher := newMockBgModule("crasher")
+	crasher.startFn = func(ctx context.Context) error {
+		crasher.errCh <- errTest
+		return nil
+	}
+	a := newTestApp(WithRestartPolicy("worker", RestartPolicy{
+		Mode:    RestartOnFailure,
+		Backoff: Backoff{Initial: 10 * time.Second},
+	}))
+	_ = a.Register(worker)
+	_ = a.Register(crasher)
+
+	begin := time.Now()
+	err := a.Run(context.Background())
+	if elapsed := time.Since(begin); elapsed > 2*time.Second {
+		t.Errorf("expected crash to shut down without waiting for backoff, took %v", elapsed)
+	}
+	var cause *ShutdownCause
+	if !errors.As(err, &cause) || cause.Module != "crasher" {
+		t.Errorf("expected background failure of crasher, got %v", err)
+	}
+	if starts.Load() != 1 {
+		t.Errorf("expected pending restart to be abandoned, got %d starts", starts.Load())
+	}
+}
+
+type lockedBgModule struct {
+	*mockBgModule
+	mu *sync.Mutex
+}
+
+func (m *lockedBgModule) Err() <-chan error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.errCh
+}